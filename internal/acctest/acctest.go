@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"strconv"
@@ -283,6 +284,23 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
+		// When VCR is replaying, configure the provider from the test's recorded interactions rather than from AWS.
+		if isVCRReplaying() {
+			r, err := loadVCRReplayer(t.Name())
+			if err != nil {
+				t.Fatalf("configuring provider: %s", err)
+			}
+
+			var meta *conns.AWSClient
+			if v, ok := Provider.Meta().(*conns.AWSClient); ok {
+				meta = v
+			} else {
+				meta = new(conns.AWSClient)
+			}
+			meta.SetHTTPClient(ctx, &http.Client{Transport: r})
+			Provider.SetMeta(meta)
+		}
+
 		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
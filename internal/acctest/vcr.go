@@ -7,22 +7,19 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -39,6 +36,22 @@ const (
 	envVarVCRPath = "VCR_PATH"
 )
 
+const (
+	// vcrModeRecording records interactions, overwriting any existing cassette.
+	vcrModeRecording = "RECORDING"
+	// vcrModeReplaying replays recorded interactions. Tests without a cassette are skipped.
+	vcrModeReplaying = "REPLAYING"
+	// vcrModeReplayOnly replays recorded interactions. Tests without a cassette fail.
+	vcrModeReplayOnly = "REPLAY_ONLY"
+)
+
+// defaultVCRPath is the default cassette directory, relative to the directory of the package under test.
+// Cassettes for each service are therefore stored alongside that service's tests.
+const defaultVCRPath = "testdata/vcr"
+
+// vcrHeaderRepeatCount is the response header recording the number of times that an interaction was repeated.
+const vcrHeaderRepeatCount = "X-Vcr-Repeat-Count"
+
 type randomnessSource struct {
 	seed   int64
 	source rand.Source
@@ -93,20 +106,55 @@ func ProviderMeta(_ context.Context, t *testing.T) *conns.AWSClient {
 }
 
 func isVCREnabled() bool {
-	return os.Getenv(envVarVCRMode) != "" && os.Getenv(envVarVCRPath) != ""
+	return os.Getenv(envVarVCRMode) != ""
 }
 
 func vcrMode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
-	case "RECORDING":
-		return recorder.ModeRecordOnce, nil
-	case "REPLAYING":
+	case vcrModeRecording:
+		return recorder.ModeRecordOnly, nil
+	case vcrModeReplaying, vcrModeReplayOnly:
 		return recorder.ModeReplayOnly, nil
 	default:
 		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
 }
 
+// isVCRReplaying returns whether VCR is enabled and replaying recorded interactions.
+func isVCRReplaying() bool {
+	if !isVCREnabled() {
+		return false
+	}
+
+	mode, err := vcrMode()
+
+	return err == nil && mode == recorder.ModeReplayOnly
+}
+
+func vcrPath() string {
+	if v := os.Getenv(envVarVCRPath); v != "" {
+		return v
+	}
+
+	return defaultVCRPath
+}
+
+// vcrCheckRecording skips or fails the test if VCR is replaying and the specified recording file is missing.
+func vcrCheckRecording(t *testing.T, fileName string) {
+	t.Helper()
+
+	if _, err := os.Stat(fileName); !os.IsNotExist(err) {
+		return
+	}
+
+	switch os.Getenv(envVarVCRMode) {
+	case vcrModeReplaying:
+		t.Skipf("no VCR recording found for %s: %s", t.Name(), fileName)
+	case vcrModeReplayOnly:
+		t.Fatalf("no VCR recording found for %s: %s", t.Name(), fileName)
+	}
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -162,103 +210,48 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 		tlsConfig.MinVersion = tls.VersionTLS12
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		path := filepath.Join(vcrPath(), vcrFileName(testName))
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		if vcrMode == recorder.ModeReplayOnly {
+			r, err := loadVCRReplayer(testName)
 
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
-		}
-
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
+			httpClient.Transport = r
+		} else {
+			// Create a VCR recorder around a default HTTP client.
+			r, err := recorder.NewWithOptions(&recorder.Options{
+				CassetteName:  path,
+				Mode:          vcrMode,
+				RealTransport: httpClient.Transport,
+			})
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			if err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+			// Remove sensitive HTTP headers and redact secrets from HTTP bodies.
+			r.AddHook(func(i *cassette.Interaction) error {
+				delete(i.Request.Headers, "Authorization")
+				delete(i.Request.Headers, "X-Amz-Security-Token")
 
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
+				i.Request.Body = vcrRedactBody(i.Request.Headers.Get("Content-Type"), i.Request.Body)
+				i.Response.Body = vcrRedactBody(i.Response.Headers.Get("Content-Type"), i.Response.Body)
 
-				return reflect.DeepEqual(requestJson, cassetteJson)
+				return nil
+			}, recorder.AfterCaptureHook)
 
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
+			// Collapse repeated identical interactions, such as those made by polling waiters.
+			r.AddHook(vcrCollapseRepeatsHook(), recorder.BeforeSaveHook)
 
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
+			httpClient.Transport = r
+		}
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -290,6 +283,137 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrCollapseRepeatsHook returns a hook that collapses consecutive identical interactions into a single interaction
+// recording the number of repeats, so that waiters polling for a status change don't bloat the cassette.
+func vcrCollapseRepeatsHook() recorder.HookFunc {
+	var (
+		previous    *cassette.Interaction
+		previousKey string
+		repeats     int
+	)
+
+	return func(i *cassette.Interaction) error {
+		key := vcrRequestKey(i.Request.Method, i.Request.URL, i.Request.Headers, i.Request.Body)
+
+		if previous != nil && key == previousKey && i.Response.Code == previous.Response.Code && i.Response.Body == previous.Response.Body {
+			repeats++
+			previous.Response.Headers.Set(vcrHeaderRepeatCount, strconv.Itoa(repeats))
+			i.DiscardOnSave = true
+
+			return nil
+		}
+
+		previous, previousKey, repeats = i, key, 1
+		if previous.Response.Headers == nil {
+			previous.Response.Headers = make(http.Header)
+		}
+
+		return nil
+	}
+}
+
+// vcrReplayer is an http.RoundTripper that replays recorded interactions.
+// Requests are matched on their normalized form, so client tokens, timestamps and field ordering don't affect matching.
+// Interactions with the same normalized request are replayed in recorded order, each as many times as it was recorded.
+// Once all such interactions have been replayed the last is repeated, so a waiter polling more times than when recording
+// sees the final recorded status.
+type vcrReplayer struct {
+	lock         sync.Mutex
+	interactions map[string][]*vcrReplayInteraction
+}
+
+type vcrReplayInteraction struct {
+	interaction *cassette.Interaction
+	remaining   int
+}
+
+func newVCRReplayer(c *cassette.Cassette) *vcrReplayer {
+	r := &vcrReplayer{
+		interactions: make(map[string][]*vcrReplayInteraction),
+	}
+
+	for _, i := range c.Interactions {
+		key := vcrRequestKey(i.Request.Method, i.Request.URL, i.Request.Headers, i.Request.Body)
+		remaining := 1
+		if v, err := strconv.Atoi(i.Response.Headers.Get(vcrHeaderRepeatCount)); err == nil && v > 0 {
+			remaining = v
+		}
+
+		r.interactions[key] = append(r.interactions[key], &vcrReplayInteraction{
+			interaction: i,
+			remaining:   remaining,
+		})
+	}
+
+	return r
+}
+
+// loadVCRReplayer returns a replayer for the interactions recorded for the specified test.
+func loadVCRReplayer(testName string) (*vcrReplayer, error) {
+	c, err := cassette.Load(filepath.Join(vcrPath(), vcrFileName(testName)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newVCRReplayer(c), nil
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *vcrReplayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body string
+
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		body = string(b)
+	}
+
+	key := vcrRequestKey(req.Method, req.URL.String(), req.Header, body)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	interactions := r.interactions[key]
+
+	if len(interactions) == 0 {
+		return nil, fmt.Errorf("%w: %s %s", cassette.ErrInteractionNotFound, req.Method, req.URL)
+	}
+
+	v := interactions[len(interactions)-1]
+	for _, i := range interactions {
+		if i.remaining > 0 {
+			v = i
+			break
+		}
+	}
+	v.remaining--
+
+	header := v.interaction.Response.Headers.Clone()
+	header.Del(vcrHeaderRepeatCount)
+
+	return &http.Response{
+		Status:           v.interaction.Response.Status,
+		StatusCode:       v.interaction.Response.Code,
+		Proto:            v.interaction.Response.Proto,
+		ProtoMajor:       v.interaction.Response.ProtoMajor,
+		ProtoMinor:       v.interaction.Response.ProtoMinor,
+		TransferEncoding: v.interaction.Response.TransferEncoding,
+		Trailer:          v.interaction.Response.Trailer,
+		ContentLength:    int64(len(v.interaction.Response.Body)),
+		Uncompressed:     v.interaction.Response.Uncompressed,
+		Body:             io.NopCloser(strings.NewReader(v.interaction.Response.Body)),
+		Header:           header,
+		Close:            true,
+		Request:          req,
+	}, nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -313,14 +437,14 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	}
 
 	switch vcrMode {
-	case recorder.ModeRecordOnce:
+	case recorder.ModeRecordOnly:
 		seed := rand.Int63()
 		s = &randomnessSource{
 			seed:   seed,
 			source: rand.NewSource(seed),
 		}
 	case recorder.ModeReplayOnly:
-		seed, err := readSeedFromFile(vcrSeedFile(os.Getenv(envVarVCRPath), testName))
+		seed, err := readSeedFromFile(vcrSeedFile(vcrPath(), testName))
 
 		if err != nil {
 			return nil, fmt.Errorf("no cassette found on disk for %s, please replay this testcase in recording mode - %w", testName, err)
@@ -343,6 +467,10 @@ func vcrFileName(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
 
+func vcrCassetteFile(path, name string) string {
+	return cassette.New(filepath.Join(path, vcrFileName(name))).File
+}
+
 func vcrSeedFile(path, name string) string {
 	return filepath.Join(path, fmt.Sprintf("%s.seed", vcrFileName(name)))
 }
@@ -369,6 +497,10 @@ func readSeedFromFile(fileName string) (int64, error) {
 }
 
 func writeSeedToFile(seed int64, fileName string) error {
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+
 	f, err := os.Create(fileName)
 
 	if err != nil {
@@ -417,7 +549,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	if ok {
 		if !t.Failed() {
 			t.Log("persisting randomness seed")
-			if err := writeSeedToFile(s.seed, vcrSeedFile(os.Getenv(envVarVCRPath), t.Name())); err != nil {
+			if err := writeSeedToFile(s.seed, vcrSeedFile(vcrPath(), t.Name())); err != nil {
 				t.Error(err)
 			}
 		}
@@ -431,6 +563,8 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
+		vcrCheckRecording(t, vcrCassetteFile(vcrPath(), t.Name()))
+
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -443,6 +577,8 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
+		vcrCheckRecording(t, vcrCassetteFile(vcrPath(), t.Name()))
+
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -458,6 +594,8 @@ func RandInt(t *testing.T) int {
 		return sdkacctest.RandInt()
 	}
 
+	vcrCheckRecording(t, vcrSeedFile(vcrPath(), t.Name()))
+
 	s, err := vcrRandomnessSource(t)
 
 	if err != nil {
@@ -0,0 +1,473 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	vcrRedacted = "REDACTED"
+	vcrIgnored  = "IGNORED"
+)
+
+// vcrIgnoredFields are the lower-cased names of request fields whose values differ on every test run.
+// They are ignored when matching requests to recorded interactions.
+var vcrIgnoredFields = []string{
+	"callerreference",
+	"clientrequesttoken",
+	"clienttoken",
+	"idempotencytoken",
+}
+
+// vcrSecretFields are the lower-cased names, without `_` or `-` separators, of fields whose string values are redacted from cassettes.
+// Names are matched exactly so that fields such as `PasswordLastUsed` or `PrivateKeyArn` are kept.
+var vcrSecretFields = []string{
+	"adminpassword",
+	"authtoken",
+	"masteruserpassword",
+	"newpassword",
+	"oldpassword",
+	"password",
+	"privatekey",
+	"secretaccesskey",
+	"secretbinary",
+	"secretstring",
+	"sessiontoken",
+}
+
+// vcrTimestampFieldWords are the lower-cased final words of the names of fields whose values are timestamps,
+// e.g. `StartTime`, `creationDate` or `last_modified_timestamp`.
+// They are ignored when matching requests to recorded interactions.
+var vcrTimestampFieldWords = []string{
+	"date",
+	"time",
+	"timestamp",
+}
+
+// vcrMinEpochSeconds is the smallest numeric value treated as a timestamp (2001-09-09T01:46:40Z).
+// Smaller values in fields named like timestamps, e.g. `WaitTime`, are durations and are matched exactly.
+const vcrMinEpochSeconds = 1e9
+
+var vcrPrivateKeyRegexp = regexache.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[^-]*-----END [A-Z ]*PRIVATE KEY-----`)
+
+type vcrBodyFormat int
+
+const (
+	vcrBodyFormatRaw vcrBodyFormat = iota
+	vcrBodyFormatJSON
+	vcrBodyFormatQuery
+	vcrBodyFormatXML
+)
+
+// vcrBodyFormatOf returns the format of an HTTP body, based on its Content-Type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodyFormatOf(contentType string) vcrBodyFormat {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return vcrBodyFormatRaw
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return vcrBodyFormatJSON
+	case "application/x-www-form-urlencoded":
+		return vcrBodyFormatQuery
+	case "application/xml", "text/xml":
+		return vcrBodyFormatXML
+	default:
+		return vcrBodyFormatRaw
+	}
+}
+
+func vcrIsIgnoredField(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, v := range vcrIgnoredFields {
+		if name == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func vcrIsSecretField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+
+	for _, v := range vcrSecretFields {
+		if name == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func vcrIsTimestampField(name string) bool {
+	word := strings.ToLower(vcrLastWord(name))
+
+	for _, v := range vcrTimestampFieldWords {
+		if word == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vcrLastWord returns the last word of a camel-case, Pascal-case or snake-case name.
+// A word starts at an upper-case letter, or after a `_` or `-`, so `Runtime` and `Mandate` are single words.
+func vcrLastWord(name string) string {
+	name = strings.TrimRight(name, "_-")
+	if i := strings.LastIndexAny(name, "_-"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	runes := []rune(name)
+	for i := len(runes) - 1; i > 0; i-- {
+		if unicode.IsUpper(runes[i]) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			return string(runes[i:])
+		}
+	}
+
+	return name
+}
+
+func vcrIsTimestampValue(value string) bool {
+	_, err := time.Parse(time.RFC3339, value)
+
+	return err == nil
+}
+
+// vcrFieldName returns the name of a field from a (possibly nested) query parameter name, e.g. `Item.member.1.ClientToken`.
+func vcrFieldName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+
+	return name
+}
+
+// vcrRequestKey returns the normalized form of an HTTP request, used to match requests to recorded interactions.
+// Fields that differ on every test run, such as client tokens and timestamps, are ignored and secrets are redacted.
+// JSON protocol requests identify their operation in the X-Amz-Target header, so different operations with the same body don't match.
+func vcrRequestKey(method, rawURL string, headers http.Header, body string) string {
+	var sb strings.Builder
+
+	sb.WriteString(method)
+	sb.WriteString(" ")
+
+	if u, err := url.Parse(rawURL); err == nil {
+		u.RawQuery = vcrNormalizeQuery(u.Query()).Encode()
+		sb.WriteString(u.String())
+	} else {
+		sb.WriteString(rawURL)
+	}
+
+	sb.WriteString("\n")
+
+	if v := headers.Get("X-Amz-Target"); v != "" {
+		sb.WriteString(v)
+		sb.WriteString("\n")
+	}
+
+	switch vcrBodyFormatOf(headers.Get("Content-Type")) {
+	case vcrBodyFormatJSON:
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err == nil {
+			if b, err := json.Marshal(vcrNormalizeJSON("", v)); err == nil {
+				body = string(b)
+			}
+		}
+	case vcrBodyFormatQuery:
+		if v, err := url.ParseQuery(body); err == nil {
+			body = vcrNormalizeQuery(v).Encode()
+		}
+	case vcrBodyFormatXML:
+		if v, err := vcrNormalizeXML(body); err == nil {
+			body = v
+		}
+	}
+
+	sb.WriteString(vcrPrivateKeyRegexp.ReplaceAllString(body, vcrRedacted))
+
+	return sb.String()
+}
+
+func vcrNormalizeValue(name string, value any) (any, bool) {
+	if vcrIsIgnoredField(name) {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case string:
+		if vcrIsSecretField(name) {
+			return vcrRedacted, true
+		}
+		if vcrIsTimestampField(name) || vcrIsTimestampValue(v) {
+			return vcrIgnored, true
+		}
+	case float64:
+		if vcrIsTimestampField(name) && v >= vcrMinEpochSeconds {
+			return vcrIgnored, true
+		}
+	}
+
+	return value, true
+}
+
+func vcrNormalizeJSON(name string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if e, ok := vcrNormalizeValue(k, vcrNormalizeJSON(k, e)); ok {
+				v[k] = e
+			} else {
+				delete(v, k)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = vcrNormalizeJSON(name, e)
+			if s, ok := v[i].(string); ok {
+				v[i], _ = vcrNormalizeValue(name, s)
+			}
+		}
+	}
+
+	return value
+}
+
+func vcrNormalizeQuery(values url.Values) url.Values {
+	for k, vs := range values {
+		name := vcrFieldName(k)
+
+		if vcrIsIgnoredField(name) {
+			delete(values, k)
+			continue
+		}
+
+		for i, v := range vs {
+			if v, ok := vcrNormalizeValue(name, v); ok {
+				vs[i] = v.(string)
+			}
+		}
+	}
+
+	return values
+}
+
+// vcrNormalizeXML returns a canonical form of an XML document.
+// Namespaces are dropped, attributes are sorted and insignificant whitespace is removed.
+func vcrNormalizeXML(body string) (string, error) {
+	var (
+		sb      strings.Builder
+		names   []string
+		ignored int
+	)
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			names = append(names, v.Name.Local)
+
+			if ignored > 0 || vcrIsIgnoredField(v.Name.Local) {
+				ignored++
+				continue
+			}
+
+			attrs := make([]string, 0, len(v.Attr))
+			for _, attr := range v.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				attrs = append(attrs, attr.Name.Local+"="+attr.Value)
+			}
+			sort.Strings(attrs)
+
+			sb.WriteString("<" + v.Name.Local)
+			for _, attr := range attrs {
+				sb.WriteString(" " + attr)
+			}
+			sb.WriteString(">")
+		case xml.EndElement:
+			names = names[:len(names)-1]
+
+			if ignored > 0 {
+				ignored--
+				continue
+			}
+
+			sb.WriteString("</" + v.Name.Local + ">")
+		case xml.CharData:
+			if ignored > 0 {
+				continue
+			}
+
+			s := strings.TrimSpace(string(v))
+			if s == "" {
+				continue
+			}
+
+			if len(names) > 0 {
+				if v, ok := vcrNormalizeValue(names[len(names)-1], s); ok {
+					s = v.(string)
+				}
+			}
+
+			sb.WriteString(s)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// vcrRedactBody returns an HTTP body with the values of secret fields and any PEM-encoded private keys redacted.
+// Bodies that cannot be parsed are only redacted of private keys.
+func vcrRedactBody(contentType, body string) string {
+	switch vcrBodyFormatOf(contentType) {
+	case vcrBodyFormatJSON:
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err == nil {
+			if vcrRedactJSON("", v) {
+				if b, err := json.Marshal(v); err == nil {
+					body = string(b)
+				}
+			}
+		}
+	case vcrBodyFormatQuery:
+		if v, err := url.ParseQuery(body); err == nil {
+			if vcrRedactQuery(v) {
+				body = v.Encode()
+			}
+		}
+	case vcrBodyFormatXML:
+		if v, err := vcrRedactXML(body); err == nil {
+			body = v
+		}
+	}
+
+	return vcrPrivateKeyRegexp.ReplaceAllString(body, vcrRedacted)
+}
+
+// vcrRedactJSON redacts the string values of secret fields in place, returning whether any values were redacted.
+func vcrRedactJSON(name string, value any) bool {
+	var redacted bool
+
+	switch v := value.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := e.(string); ok && vcrIsSecretField(k) {
+				v[k] = vcrRedacted
+				redacted = true
+			} else if vcrRedactJSON(k, e) {
+				redacted = true
+			}
+		}
+	case []any:
+		for i, e := range v {
+			if _, ok := e.(string); ok && vcrIsSecretField(name) {
+				v[i] = vcrRedacted
+				redacted = true
+			} else if vcrRedactJSON(name, e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// vcrRedactQuery redacts the values of secret fields in place, returning whether any values were redacted.
+func vcrRedactQuery(values url.Values) bool {
+	var redacted bool
+
+	for k, vs := range values {
+		if vcrIsSecretField(vcrFieldName(k)) {
+			for i := range vs {
+				vs[i] = vcrRedacted
+			}
+			redacted = true
+		}
+	}
+
+	return redacted
+}
+
+// vcrRedactXML redacts the character data of secret elements.
+// The document is otherwise unchanged.
+func vcrRedactXML(body string) (string, error) {
+	type span struct {
+		start, end int64
+	}
+
+	var (
+		spans []span
+		names []string
+	)
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+
+	for {
+		start := decoder.InputOffset()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch v := token.(type) {
+		case xml.StartElement:
+			names = append(names, v.Name.Local)
+		case xml.EndElement:
+			names = names[:len(names)-1]
+		case xml.CharData:
+			if len(names) > 0 && vcrIsSecretField(names[len(names)-1]) && len(bytes.TrimSpace(v)) > 0 {
+				spans = append(spans, span{start: start, end: decoder.InputOffset()})
+			}
+		}
+	}
+
+	if len(spans) == 0 {
+		return body, nil
+	}
+
+	var sb strings.Builder
+	var offset int64
+
+	for _, v := range spans {
+		sb.WriteString(body[offset:v.start])
+		sb.WriteString(vcrRedacted)
+		offset = v.end
+	}
+	sb.WriteString(body[offset:])
+
+	return sb.String(), nil
+}
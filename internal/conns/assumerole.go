@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// assumeRoleChain returns a credentials provider for the last IAM Role in a chain of roles.
+// The first role in the chain has already been assumed and its credentials are those in the specified AWS SDK for Go v2 configuration.
+// Each subsequent role is assumed using the previous role's credentials.
+func assumeRoleChain(ctx context.Context, cfg aws_sdkv2.Config, roles []*awsbase.AssumeRole, stsEndpoint, stsRegion string) (aws_sdkv2.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	credentialsProvider := cfg.Credentials
+	n := len(roles)
+
+	for i := 1; i < n; i++ {
+		ar := roles[i]
+		hop := fmt.Sprintf("assume_role %d of %d", i+1, n)
+
+		if ar == nil || ar.RoleARN == "" {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot assume IAM Role (%s)", hop),
+				Detail:   "IAM Role ARN not set",
+			})
+		}
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.index":           i,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		hopCfg := cfg.Copy()
+		hopCfg.Credentials = credentialsProvider
+
+		client := sts_sdkv2.NewFromConfig(hopCfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		credentialsProvider = aws_sdkv2.NewCredentialsCache(stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds_sdkv2.AssumeRoleOptions) {
+			expandAssumeRoleOptions(opts, ar)
+		}))
+
+		// Retrieve credentials now so that any error identifies the failing role.
+		// The cached credentials are then used to assume the next role.
+		if _, err := credentialsProvider.Retrieve(ctx); err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot assume IAM Role (%s)", hop),
+				Detail:   fmt.Sprintf("IAM Role (%s) cannot be assumed using the credentials of the previous role.\n\nError: %s", ar.RoleARN, err),
+			})
+		}
+	}
+
+	return credentialsProvider, diags
+}
+
+func expandAssumeRoleOptions(opts *stscreds_sdkv2.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	opts.RoleSessionName = ar.SessionName
+	opts.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		opts.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		opts.PolicyARNs = append(opts.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	for k, v := range ar.Tags {
+		opts.Tags = append(opts.Tags, ststypes_sdkv2.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	if len(ar.TransitiveTagKeys) > 0 {
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	}
+
+	if ar.SourceIdentity != "" {
+		opts.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	credentials_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestExpandAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	ar := &awsbase.AssumeRole{
+		RoleARN:           "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		Duration:          time.Hour,
+		ExternalID:        "external",
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+		SessionName:       "session",
+		SourceIdentity:    "source",
+		Tags:              map[string]string{"key": "value"},
+		TransitiveTagKeys: []string{"key"},
+	}
+
+	var opts stscreds_sdkv2.AssumeRoleOptions
+	expandAssumeRoleOptions(&opts, ar)
+
+	if got, want := opts.RoleSessionName, "session"; got != want {
+		t.Errorf("RoleSessionName = %s, want %s", got, want)
+	}
+	if got, want := opts.Duration, time.Hour; got != want {
+		t.Errorf("Duration = %s, want %s", got, want)
+	}
+	if opts.ExternalID == nil || *opts.ExternalID != "external" {
+		t.Errorf("ExternalID = %v, want external", opts.ExternalID)
+	}
+	if opts.Policy != nil {
+		t.Errorf("Policy = %v, want nil", opts.Policy)
+	}
+	if got, want := len(opts.PolicyARNs), 1; got != want {
+		t.Errorf("len(PolicyARNs) = %d, want %d", got, want)
+	}
+	if got, want := len(opts.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+	if got, want := len(opts.TransitiveTagKeys), 1; got != want {
+		t.Errorf("len(TransitiveTagKeys) = %d, want %d", got, want)
+	}
+	if opts.SourceIdentity == nil || *opts.SourceIdentity != "source" {
+		t.Errorf("SourceIdentity = %v, want source", opts.SourceIdentity)
+	}
+}
+
+func TestAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	const (
+		intermediateRoleARN = "arn:aws:iam::111111111111:role/intermediate" //lintignore:AWSAT005
+		targetRoleARN       = "arn:aws:iam::222222222222:role/target"       //lintignore:AWSAT005
+		deniedRoleARN       = "arn:aws:iam::333333333333:role/denied"       //lintignore:AWSAT005
+	)
+
+	testCases := map[string]struct {
+		roles          []string
+		wantAccessKey  string
+		wantAssumed    []string
+		wantErrSummary string
+	}{
+		"multiple hops": {
+			roles: []string{"arn:aws:iam::000000000000:role/first", intermediateRoleARN, targetRoleARN}, //lintignore:AWSAT005
+			// Each role is assumed using the previous role's credentials.
+			wantAccessKey: "AKID-target",
+			wantAssumed: []string{
+				intermediateRoleARN + " with base",
+				targetRoleARN + " with AKID-intermediate",
+			},
+		},
+		"failure on last hop": {
+			roles:          []string{"arn:aws:iam::000000000000:role/first", intermediateRoleARN, deniedRoleARN}, //lintignore:AWSAT005
+			wantAssumed:    []string{intermediateRoleARN + " with base"},
+			wantErrSummary: "Cannot assume IAM Role (assume_role 3 of 3)",
+		},
+		"missing role ARN": {
+			roles:          []string{"arn:aws:iam::000000000000:role/first", "", targetRoleARN}, //lintignore:AWSAT005
+			wantErrSummary: "Cannot assume IAM Role (assume_role 2 of 3)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			var (
+				mu      sync.Mutex
+				assumed []string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				roleARN := r.PostForm.Get("RoleArn")
+				if roleARN == deniedRoleARN {
+					w.WriteHeader(http.StatusForbidden)
+					fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>not authorized</Message></Error><RequestId>1</RequestId></ErrorResponse>`)
+					return
+				}
+
+				// Authorization: AWS4-HMAC-SHA256 Credential=<access key>/...
+				accessKey, _, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="), "/")
+				mu.Lock()
+				assumed = append(assumed, roleARN+" with "+accessKey)
+				mu.Unlock()
+
+				roleName := roleARN[strings.LastIndex(roleARN, "/")+1:]
+				fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials><AccessKeyId>AKID-%[1]s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>%[2]s</Expiration></Credentials><AssumedRoleUser><Arn>%[3]s</Arn><AssumedRoleId>%[1]s</AssumedRoleId></AssumedRoleUser></AssumeRoleResult><ResponseMetadata><RequestId>1</RequestId></ResponseMetadata></AssumeRoleResponse>`,
+					roleName, time.Now().Add(time.Hour).UTC().Format(time.RFC3339), roleARN)
+			}))
+			t.Cleanup(server.Close)
+
+			cfg := aws_sdkv2.Config{
+				Credentials: credentials_sdkv2.NewStaticCredentialsProvider("base", "secret", ""),
+				Region:      "us-west-2", //lintignore:AWSAT003
+			}
+			var roles []*awsbase.AssumeRole
+			for _, v := range testCase.roles {
+				roles = append(roles, &awsbase.AssumeRole{RoleARN: v})
+			}
+
+			credentialsProvider, diags := assumeRoleChain(ctx, cfg, roles, server.URL, "")
+
+			if got, want := strings.Join(assumed, ", "), strings.Join(testCase.wantAssumed, ", "); got != want {
+				t.Errorf("assumed roles = %q, want %q", got, want)
+			}
+
+			if testCase.wantErrSummary != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error")
+				}
+				if got, want := diags[0].Summary, testCase.wantErrSummary; got != want {
+					t.Errorf("summary = %q, want %q", got, want)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			credentials, err := credentialsProvider.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+			if got, want := credentials.AccessKeyID, testCase.wantAccessKey; got != want {
+				t.Errorf("AccessKeyID = %s, want %s", got, want)
+			}
+		})
+	}
+}
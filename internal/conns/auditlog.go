@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// apiAuditLog writes one JSON line per AWS API call.
+// Request and response bodies are never written.
+type apiAuditLog struct {
+	accountID string
+	lock      sync.Mutex
+	now       func() time.Time
+	w         io.Writer
+}
+
+// apiAuditLogFiles are the open API audit log files, keyed by path.
+// Each file is shared by all provider configurations that log to it, rather than opened on every configure.
+var (
+	apiAuditLogFiles     = make(map[string]*apiAuditLogFile)
+	apiAuditLogFilesLock sync.Mutex
+)
+
+// apiAuditLogFile serializes writes from all of the API audit logs sharing a file.
+type apiAuditLogFile struct {
+	f    *os.File
+	lock sync.Mutex
+}
+
+func (f *apiAuditLogFile) Write(b []byte) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.f.Write(b)
+}
+
+// openAPIAuditLogFile returns the API audit log file at the specified path, opening it for append if not already open.
+func openAPIAuditLogFile(path string) (io.Writer, error) {
+	apiAuditLogFilesLock.Lock()
+	defer apiAuditLogFilesLock.Unlock()
+
+	if v, ok := apiAuditLogFiles[path]; ok {
+		return v, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &apiAuditLogFile{f: f}
+	apiAuditLogFiles[path] = v
+
+	return v, nil
+}
+
+// CloseAPIAuditLogs closes all open API audit log files.
+// It is called when the provider stops.
+func CloseAPIAuditLogs() error {
+	apiAuditLogFilesLock.Lock()
+	defer apiAuditLogFilesLock.Unlock()
+
+	var errs []error
+	for path, v := range apiAuditLogFiles {
+		v.lock.Lock()
+		errs = append(errs, v.f.Close())
+		v.lock.Unlock()
+
+		delete(apiAuditLogFiles, path)
+	}
+
+	return errors.Join(errs...)
+}
+
+type apiAuditLogEntry struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	AccountID    string    `json:"account_id,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	RetryCount   int       `json:"retry_count"`
+	LatencyMS    int64     `json:"latency_ms"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"id,omitempty"` // The same key as logging.KeyResourceId.
+}
+
+func newAPIAuditLog(w io.Writer, accountID string) *apiAuditLog {
+	return &apiAuditLog{
+		accountID: accountID,
+		now:       time.Now,
+		w:         w,
+	}
+}
+
+func (l *apiAuditLog) write(ctx context.Context, entry apiAuditLogEntry) {
+	entry.AccountID = l.accountID
+	if v, ok := FromContext(ctx); ok {
+		entry.ResourceType = v.TypeName
+		if v.ResourceID != nil {
+			entry.ResourceID = v.ResourceID()
+		}
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Failures to write the audit log don't fail the API call.
+	_, _ = l.w.Write(b)
+}
+
+// addMiddleware adds the audit log to an AWS SDK for Go v2 middleware stack.
+// It runs once per operation, after all attempts.
+func (l *apiAuditLog) addMiddleware(stack *middleware.Stack) error {
+	const id = "APIAuditLog"
+	m := middleware.InitializeMiddlewareFunc(id, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := l.now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		entry := apiAuditLogEntry{
+			Timestamp: start.UTC(),
+			Service:   awsmiddleware_sdkv2.GetServiceID(ctx),
+			Operation: awsmiddleware_sdkv2.GetOperationName(ctx),
+			Region:    awsmiddleware_sdkv2.GetRegion(ctx),
+			LatencyMS: l.now().Sub(start).Milliseconds(),
+		}
+
+		if v, ok := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata); ok {
+			entry.RequestID = v
+		}
+		if v, ok := awsmiddleware_sdkv2.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+			entry.HTTPStatus = v.StatusCode
+		}
+		if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			entry.RetryCount = len(v.Results) - 1
+		}
+
+		if err != nil {
+			var respErr *awshttp_sdkv2.ResponseError
+			if errors.As(err, &respErr) {
+				entry.RequestID = respErr.ServiceRequestID()
+				entry.HTTPStatus = respErr.HTTPStatusCode()
+			}
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				entry.ErrorCode = apiErr.ErrorCode()
+			}
+		}
+
+		l.write(ctx, entry)
+
+		return out, metadata, err
+	})
+
+	return stack.Initialize.Add(m, middleware.After)
+}
+
+// completeHandler is an AWS SDK for Go v1 request handler that runs once per request, after all attempts.
+func (l *apiAuditLog) completeHandler() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "terraform-provider-aws.APIAuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			entry := apiAuditLogEntry{
+				Timestamp:  r.Time.UTC(),
+				Service:    r.ClientInfo.ServiceID,
+				Region:     r.ClientInfo.SigningRegion,
+				RequestID:  r.RequestID,
+				RetryCount: r.RetryCount,
+				LatencyMS:  l.now().Sub(r.Time).Milliseconds(),
+			}
+
+			if r.Operation != nil {
+				entry.Operation = r.Operation.Name
+			}
+			if r.Config.Region != nil {
+				entry.Region = *r.Config.Region
+			}
+			if r.HTTPResponse != nil {
+				entry.HTTPStatus = r.HTTPResponse.StatusCode
+			}
+			if err, ok := r.Error.(awserr.Error); ok {
+				entry.ErrorCode = err.Code()
+			}
+
+			l.write(r.Context(), entry)
+		},
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+func TestAPIAuditLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status int
+		body   string
+		want   apiAuditLogEntry
+	}{
+		"success": {
+			status: http.StatusOK,
+			body:   `<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`,
+			want: apiAuditLogEntry{
+				Service:      "STS",
+				Operation:    "GetCallerIdentity",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				AccountID:    "123456789012",
+				RequestID:    "request-1",
+				HTTPStatus:   http.StatusOK,
+				ResourceType: "aws_test",
+				ResourceID:   "test-id",
+			},
+		},
+		"error": {
+			status: http.StatusForbidden,
+			body:   `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error></ErrorResponse>`,
+			want: apiAuditLogEntry{
+				Service:      "STS",
+				Operation:    "GetCallerIdentity",
+				Region:       "us-west-2", //lintignore:AWSAT003
+				AccountID:    "123456789012",
+				RequestID:    "request-1",
+				HTTPStatus:   http.StatusForbidden,
+				ErrorCode:    "AccessDenied",
+				ResourceType: "aws_test",
+				ResourceID:   "test-id",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			l := newAPIAuditLog(&buf, "123456789012")
+
+			client := sts.New(sts.Options{
+				APIOptions:  []func(*middleware.Stack) error{l.addMiddleware},
+				Credentials: aws.AnonymousCredentials{},
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					if r.Body != nil {
+						defer r.Body.Close()
+					}
+					header := http.Header{}
+					header.Set("X-Amzn-Requestid", "request-1")
+					return &http.Response{
+						StatusCode: testCase.status,
+						Header:     header,
+						Body:       io.NopCloser(strings.NewReader(testCase.body)),
+					}, nil
+				}),
+				Region: "us-west-2", //lintignore:AWSAT003
+			})
+
+			ctx := NewResourceContext(context.Background(), "Test", "Test", "aws_test")
+			if v, ok := FromContext(ctx); ok {
+				v.ResourceID = func() string { return "test-id" }
+			}
+			client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}) //nolint:errcheck // The error case is expected.
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			if got, want := len(lines), 1; got != want {
+				t.Fatalf("lines = %d, want %d", got, want)
+			}
+
+			var got apiAuditLogEntry
+			if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+				t.Fatalf("unmarshaling audit log entry: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreFields(apiAuditLogEntry{}, "Timestamp", "LatencyMS")); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if !strings.Contains(lines[0], `"`+logging.KeyResourceId+`":"test-id"`) {
+				t.Errorf("audit log entry (%s) doesn't log the resource ID under %q", lines[0], logging.KeyResourceId)
+			}
+			if strings.Contains(lines[0], "123456789012</Account>") {
+				t.Error("audit log contains response body")
+			}
+		})
+	}
+}
+
+func TestOpenAPIAuditLogFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	w1, err := openAPIAuditLogFile(path)
+	if err != nil {
+		t.Fatalf("opening audit log: %s", err)
+	}
+	w2, err := openAPIAuditLogFile(path)
+	if err != nil {
+		t.Fatalf("opening audit log: %s", err)
+	}
+
+	if w1 != w2 {
+		t.Error("audit log file opened twice")
+	}
+
+	newAPIAuditLog(w1, "123456789012").write(context.Background(), apiAuditLogEntry{Operation: "One"})
+	newAPIAuditLog(w2, "123456789012").write(context.Background(), apiAuditLogEntry{Operation: "Two"})
+
+	if err := CloseAPIAuditLogs(); err != nil {
+		t.Fatalf("closing audit logs: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %s", err)
+	}
+
+	if got, want := strings.Count(string(b), "\n"), 2; got != want {
+		t.Errorf("lines = %d, want %d", got, want)
+	}
+
+	apiAuditLogFilesLock.Lock()
+	defer apiAuditLogFilesLock.Unlock()
+
+	if got := len(apiAuditLogFiles); got != 0 {
+		t.Errorf("open audit log files = %d, want 0", got)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
@@ -23,27 +24,33 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-	Session           *session_sdkv1.Session
-	TerraformVersion  string
+	AccountID             string
+	DefaultTagsConfig     *tftags.DefaultConfig
+	DefaultTimeoutsConfig DefaultTimeoutsConfig
+	DeletionGuardConfig   *DeletionGuardConfig
+	IAMPreflightMode      string
+	IgnoreTagsConfig      *tftags.IgnoreConfig
+	Partition             string
+	Region                string
+	RequiredTagsConfig    *tftags.RequiredConfig
+	ServicePackages       map[string]ServicePackage
+	Session               *session_sdkv1.Session
+	TerraformVersion      string
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	dataSourceCache           *dataSourceCache
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3_sdkv2.Client
+	s3UsePathStyle            bool                     // From provider configuration.
+	s3USEast1RegionalEndpoint string                   // From provider configuration.
+	serviceRetry              map[string]*serviceRetry // From provider configuration.
+	stsRegion                 string                   // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -58,6 +65,15 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
+// EffectiveRegion returns the AWS Region that API calls made with Context will be sent to.
+// This is the per-resource `region` value if one was set, else the provider's configured Region.
+func (c *AWSClient) EffectiveRegion(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+	return c.Region
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -69,7 +85,7 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.EffectiveRegion(ctx), c.DNSSuffix(ctx))
 }
 
 // S3ExpressClient returns an S3 API client suitable for use with S3 Express (directory buckets).
@@ -77,21 +93,28 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	s3Client := c.S3Client(ctx)
+	region := c.EffectiveRegion(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	if c.s3ExpressClients == nil {
+		c.s3ExpressClients = make(map[string]*s3_sdkv2.Client)
+	}
+
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == names.GlobalRegionID {
-			c.s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -192,6 +215,18 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.Session,
 	}
+	// Per-resource Region override.
+	if region := c.EffectiveRegion(ctx); region != c.Region {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = c.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+	}
+	// Per-service retry and rate limit policy.
+	if v, ok := c.serviceRetry[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = v.awsConfig(m["aws_sdkv2_config"].(*aws_sdkv2.Config))
+		m["session"] = v.session(m["session"].(*session_sdkv1.Session))
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -207,6 +242,15 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 
 	return m
 }
+
+// apiClientCacheKey returns the key under which the default API client for the specified service and AWS Region is cached.
+func (c *AWSClient) apiClientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.EffectiveRegion(ctx); region != c.Region {
+		return servicePackageName + "@" + region
+	}
+	return servicePackageName
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
@@ -261,15 +305,17 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
+// The API client is configured for the AWS Region returned by EffectiveRegion.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
-	// Default service client is cached.
+	key := c.apiClientCacheKey(ctx, servicePackageName)
+	// Default service client is cached per AWS Region.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -313,22 +359,24 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
+// The API client is configured for the AWS Region returned by EffectiveRegion.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	isDefault := len(extra) == 0
-	// Default service client is cached.
+	key := c.apiClientCacheKey(ctx, servicePackageName)
+	// Default service client is cached per AWS Region.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -363,7 +411,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
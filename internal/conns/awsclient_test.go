@@ -93,3 +93,30 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientEffectiveRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := context.TODO()
+	if got, want := client.EffectiveRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (no resource Context) = %s, want %s", got, want)
+	}
+
+	ctx = NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+	if got, want := client.EffectiveRegion(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (no override) = %s, want %s", got, want)
+	}
+
+	inContext, _ := FromContext(ctx)
+	inContext.OverrideRegion = "eu-west-1"                                  //lintignore:AWSAT003
+	if got, want := client.EffectiveRegion(ctx), "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("EffectiveRegion (override) = %s, want %s", got, want)
+	}
+	if got, want := client.apiClientCacheKey(ctx, "ec2"), "ec2@eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("apiClientCacheKey (override) = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// dataSourceCache is a read-through cache of AWS API results for data sources.
+// Concurrent reads of the same key result in a single call to AWS.
+// Errors are not cached.
+type dataSourceCache struct {
+	entries map[string]*dataSourceCacheEntry
+	lock    sync.Mutex
+	now     func() time.Time
+	ttl     time.Duration
+}
+
+type dataSourceCacheEntry struct {
+	done    chan struct{}
+	err     error
+	expires time.Time
+	value   any
+}
+
+func newDataSourceCache(ttl time.Duration) *dataSourceCache {
+	return &dataSourceCache{
+		entries: make(map[string]*dataSourceCacheEntry),
+		now:     time.Now,
+		ttl:     ttl,
+	}
+}
+
+func (c *dataSourceCache) read(ctx context.Context, key string, f func(context.Context) (any, error)) (any, error) {
+	c.lock.Lock()
+	if e, ok := c.entries[key]; ok {
+		select {
+		case <-e.done:
+			if c.now().Before(e.expires) {
+				c.lock.Unlock()
+				tflog.Debug(ctx, "Data source cache hit", map[string]any{
+					"tf_aws.data_source_cache.key": key,
+				})
+				return e.value, nil
+			}
+		default:
+			// In flight.
+			c.lock.Unlock()
+			<-e.done
+			if e.err == nil {
+				return e.value, nil
+			}
+			return f(ctx)
+		}
+	}
+
+	e := &dataSourceCacheEntry{
+		done: make(chan struct{}),
+	}
+	c.entries[key] = e
+	c.lock.Unlock()
+
+	e.value, e.err = f(ctx)
+
+	c.lock.Lock()
+	if e.err == nil {
+		e.expires = c.now().Add(c.ttl)
+	} else {
+		delete(c.entries, key)
+	}
+	close(e.done)
+	c.lock.Unlock()
+
+	return e.value, e.err
+}
+
+// dataSourceCacheKey returns the cache key for an AWS API operation.
+// The operation's input is normalized by marshaling to JSON. Inputs that cannot be marshaled are not cached.
+func dataSourceCacheKey(servicePackageName, region, operation string, input any) (string, bool) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.Join([]string{servicePackageName, region, operation, string(b)}, "|"), true
+}
+
+// CachedRead returns the result of calling f, an AWS API operation with the specified name and input.
+// If called from a data source that has opted in to caching and the provider's data source cache is enabled,
+// the result of an earlier call with the same service, Region, operation and input is returned if it has not expired.
+// Cached results are shared and must not be modified.
+// Resources, including their reads after create or update, never use cached results.
+func CachedRead[T any](ctx context.Context, c *AWSClient, operation string, input any, f func(context.Context) (T, error)) (T, error) {
+	inContext, ok := FromContext(ctx)
+	if !ok || !inContext.IsDataSource || !inContext.Cacheable || c.dataSourceCache == nil {
+		return f(ctx)
+	}
+
+	key, ok := dataSourceCacheKey(inContext.ServicePackageName, c.EffectiveRegion(ctx), operation, input)
+	if !ok {
+		return f(ctx)
+	}
+
+	v, err := c.dataSourceCache.read(ctx, key, func(ctx context.Context) (any, error) {
+		return f(ctx)
+	})
+	if err != nil || v == nil {
+		var zero T
+		return zero, err
+	}
+
+	return v.(T), nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCachedRead(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Name *string
+	}
+
+	name := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		ctx       func(context.Context) context.Context
+		inputs    []input
+		err       error
+		advance   time.Duration
+		wantCalls int
+	}{
+		"cacheable data source": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewDataSourceContext(ctx, "ec2", "AMI", "aws_ami", true)
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("a")}},
+			wantCalls: 1,
+		},
+		"cacheable data source different inputs": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewDataSourceContext(ctx, "ec2", "AMI", "aws_ami", true)
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("b")}},
+			wantCalls: 2,
+		},
+		"cacheable data source expired": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewDataSourceContext(ctx, "ec2", "AMI", "aws_ami", true)
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("a")}},
+			advance:   2 * time.Minute,
+			wantCalls: 2,
+		},
+		"cacheable data source error": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewDataSourceContext(ctx, "ec2", "AMI", "aws_ami", true)
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("a")}},
+			err:       errors.New("throttled"),
+			wantCalls: 2,
+		},
+		"non-cacheable data source": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewDataSourceContext(ctx, "ec2", "AMI", "aws_ami", false)
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("a")}},
+			wantCalls: 2,
+		},
+		"resource": {
+			ctx: func(ctx context.Context) context.Context {
+				return NewResourceContext(ctx, "ec2", "AMI", "aws_ami")
+			},
+			inputs:    []input{{Name: name("a")}, {Name: name("a")}},
+			wantCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testCase.ctx(context.Background())
+			now := time.Now()
+			client := &AWSClient{
+				dataSourceCache: newDataSourceCache(time.Minute),
+			}
+			client.dataSourceCache.now = func() time.Time { return now }
+
+			var calls int
+			for _, input := range testCase.inputs {
+				output, err := CachedRead(ctx, client, "Describe", input, func(context.Context) (*string, error) {
+					calls++
+					return input.Name, testCase.err
+				})
+
+				if !errors.Is(err, testCase.err) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if err == nil && *output != *input.Name {
+					t.Errorf("output = %q, want %q", *output, *input.Name)
+				}
+
+				now = now.Add(testCase.advance)
+			}
+
+			if calls != testCase.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, testCase.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCachedReadDisabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewDataSourceContext(context.Background(), "sts", "Caller Identity", "aws_caller_identity", true)
+	client := &AWSClient{}
+
+	var calls int
+	for i := 0; i < 2; i++ {
+		if _, err := CachedRead(ctx, client, "GetCallerIdentity", struct{}{}, func(context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
@@ -26,11 +27,15 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APIAuditLogPath                string // Empty disables the API audit log.
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole // Roles are assumed in order, each using the previous role's credentials.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
+	DataSourceCacheTTL             time.Duration // Zero disables the data source cache.
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          DefaultTimeoutsConfig
+	DeletionGuardConfig            *DeletionGuardConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -38,16 +43,19 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPreflightMode               string // Empty disables the IAM permission preflight.
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRetry                   map[string]ServiceRetryConfig // Keyed by service package name.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -101,8 +109,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role is assumed by aws-sdk-go-base. Any further roles are chained below.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
+	} else if n := len(c.AssumeRole); n > 1 {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot assume IAM Role (assume_role 1 of %d)", n),
+			Detail:   "IAM Role ARN not set",
+		})
 	}
 
 	if c.CustomCABundle != "" {
@@ -135,9 +150,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		summary := d.Summary()
+		if n := len(c.AssumeRole); n > 1 && summary == "Cannot assume IAM Role" {
+			summary = fmt.Sprintf("%s (assume_role 1 of %d)", summary, n)
+		}
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSdkSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Summary:  summary,
 			Detail:   d.Detail(),
 		})
 	}
@@ -146,6 +165,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.AssumeRole) > 1 {
+		tflog.Debug(ctx, "Assuming chained IAM Roles")
+		credentialsProvider, ds := assumeRoleChain(ctx, cfg, c.AssumeRole, c.Endpoints[names.STS], c.STSRegion)
+		diags = append(diags, ds...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		cfg.Credentials = credentialsProvider
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -186,6 +217,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
+	if c.APIAuditLogPath != "" {
+		f, err := openAPIAuditLogFile(c.APIAuditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API audit log (%s): %s", c.APIAuditLogPath, err)
+		}
+
+		// Registered once, for all AWS SDK for Go v1 and v2 API clients.
+		l := newAPIAuditLog(f, accountID)
+		cfg.APIOptions = append(cfg.APIOptions, l.addMiddleware)
+		sess.Handlers.Complete.PushBackNamed(l.completeHandler())
+	}
+
 	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
@@ -198,10 +241,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeoutsConfig = c.DefaultTimeoutsConfig
+	client.DeletionGuardConfig = c.DeletionGuardConfig
 	client.dnsSuffix = dnsSuffix
+	client.IAMPreflightMode = c.IAMPreflightMode
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.SetHTTPClient(ctx, sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
@@ -210,9 +257,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
+	if c.DataSourceCacheTTL > 0 {
+		client.dataSourceCache = newDataSourceCache(c.DataSourceCacheTTL)
+	}
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
+	if len(c.ServiceRetry) > 0 {
+		client.serviceRetry = make(map[string]*serviceRetry, len(c.ServiceRetry))
+		for k, v := range c.ServiceRetry {
+			client.serviceRetry[k] = newServiceRetry(v)
+		}
+	}
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
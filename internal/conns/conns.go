@@ -7,6 +7,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -35,25 +36,32 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	Cacheable          bool          // Data source reads may be served from the provider's data source cache?
+	IsDataSource       bool          // Data source?
+	OverrideRegion     string        // Per-resource AWS Region, if different from the provider's configured Region
+	ResourceID         func() string // Returns the ID of the resource being operated on, logged under logging.KeyResourceId
+	ResourceName       string        // Friendly resource name, e.g. "Subnet"
+	ServicePackageName string        // Canonical name defined as a constant in names package
+	TypeName           string        // Resource or data source type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string, cacheable bool) context.Context {
 	v := InContext{
+		Cacheable:          cacheable,
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -64,6 +72,18 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// importIDRegionRegexp matches an import ID with an `@region` suffix.
+var importIDRegionRegexp = regexache.MustCompile(`^(.+)@([a-z]{2}(?:-[a-z]+)+-\d)$`)
+
+// SplitImportIDRegion splits an import ID of the form `ID@region` into its ID and AWS Region parts.
+// If the import ID has no `@region` suffix the returned Region is empty.
+func SplitImportIDRegion(id string) (string, string) {
+	if m := importIDRegionRegexp.FindStringSubmatch(id); m != nil {
+		return m[1], m[2]
+	}
+	return id, ""
+}
+
 func NewSessionForRegion(cfg *aws_sdkv1.Config, region, terraformVersion string) (*session_sdkv1.Session, error) {
 	session, err := session_sdkv1.NewSession(cfg)
 
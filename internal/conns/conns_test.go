@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "no suffix",
+			ImportID:   "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			Name:           "region suffix",
+			ImportID:       "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:           "GovCloud region suffix",
+			ImportID:       "vpc-12345678@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:       "email address",
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:       "region only",
+			ImportID:   "@eu-west-1", //lintignore:AWSAT003
+			ExpectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region := SplitImportIDRegion(testCase.ImportID)
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
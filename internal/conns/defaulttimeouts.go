@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"path"
+	"time"
+)
+
+// DefaultTimeouts are operation timeouts defaulted across resources of matching types.
+// Zero values leave the resource's own default in effect.
+type DefaultTimeouts struct {
+	ResourceType string // Resource type name pattern, as used by path.Match.
+	Create       time.Duration
+	Read         time.Duration
+	Update       time.Duration
+	Delete       time.Duration
+}
+
+// DefaultTimeoutsConfig is the provider's default timeouts configuration.
+type DefaultTimeoutsConfig []DefaultTimeouts
+
+// For returns the default timeouts for resources of the specified type.
+// When several entries match, later entries take precedence over earlier ones.
+func (c DefaultTimeoutsConfig) For(typeName string) (DefaultTimeouts, bool) {
+	result := DefaultTimeouts{
+		ResourceType: typeName,
+	}
+	found := false
+
+	for _, v := range c {
+		// Patterns are validated in the provider schema.
+		if ok, _ := path.Match(v.ResourceType, typeName); !ok {
+			continue
+		}
+
+		found = true
+
+		if v.Create > 0 {
+			result.Create = v.Create
+		}
+		if v.Read > 0 {
+			result.Read = v.Read
+		}
+		if v.Update > 0 {
+			result.Update = v.Update
+		}
+		if v.Delete > 0 {
+			result.Delete = v.Delete
+		}
+	}
+
+	return result, found
+}
+
+type defaultTimeoutsContextKeyType int
+
+var defaultTimeoutsContextKey defaultTimeoutsContextKeyType
+
+// NewDefaultTimeoutsContext returns a Context carrying the provider's default timeouts for the resource being operated on.
+func NewDefaultTimeoutsContext(ctx context.Context, v DefaultTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey, v)
+}
+
+// DefaultTimeoutsFromContext returns the provider's default timeouts for the resource being operated on.
+func DefaultTimeoutsFromContext(ctx context.Context) (DefaultTimeouts, bool) {
+	v, ok := ctx.Value(defaultTimeoutsContextKey).(DefaultTimeouts)
+
+	return v, ok
+}
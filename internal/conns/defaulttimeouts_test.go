@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultTimeoutsConfigFor(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultTimeoutsConfig{
+		{ResourceType: "aws_db_*", Create: 60 * time.Minute, Delete: 60 * time.Minute},
+		{ResourceType: "aws_db_instance", Delete: 90 * time.Minute},
+		{ResourceType: "aws_eks_cluster", Create: 45 * time.Minute},
+	}
+
+	testCases := map[string]struct {
+		config    DefaultTimeoutsConfig
+		typeName  string
+		want      DefaultTimeouts
+		wantFound bool
+	}{
+		"nil config": {
+			typeName: "aws_db_instance",
+			want:     DefaultTimeouts{ResourceType: "aws_db_instance"},
+		},
+		"no match": {
+			config:   config,
+			typeName: "aws_rds_cluster",
+			want:     DefaultTimeouts{ResourceType: "aws_rds_cluster"},
+		},
+		"glob match": {
+			config:    config,
+			typeName:  "aws_db_snapshot",
+			want:      DefaultTimeouts{ResourceType: "aws_db_snapshot", Create: 60 * time.Minute, Delete: 60 * time.Minute},
+			wantFound: true,
+		},
+		"later takes precedence": {
+			config:    config,
+			typeName:  "aws_db_instance",
+			want:      DefaultTimeouts{ResourceType: "aws_db_instance", Create: 60 * time.Minute, Delete: 90 * time.Minute},
+			wantFound: true,
+		},
+		"exact match": {
+			config:    config,
+			typeName:  "aws_eks_cluster",
+			want:      DefaultTimeouts{ResourceType: "aws_eks_cluster", Create: 45 * time.Minute},
+			wantFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotFound := testCase.config.For(testCase.typeName)
+
+			if got, want := gotFound, testCase.wantFound; got != want {
+				t.Errorf("found = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("For(%q) = %+v, want %+v", testCase.typeName, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"path"
+)
+
+const DeletionGuardSummary = "Deletion prevented by provider deletion_guard"
+
+// DeletionGuardConfig is the provider's deletion guard configuration.
+// A resource is protected from deletion if its type matches any of the resource type patterns
+// and it has all of the tags.
+type DeletionGuardConfig struct {
+	ResourceTypes []string // Resource type name patterns, as used by path.Match.
+	Tags          map[string]string
+}
+
+// Protects returns whether or not a resource of the specified type with the specified tags cannot be deleted.
+func (c *DeletionGuardConfig) Protects(typeName string, tags map[string]string) bool {
+	if c == nil {
+		return false
+	}
+
+	matched := false
+	for _, pattern := range c.ResourceTypes {
+		// Patterns are validated in the provider schema.
+		if ok, _ := path.Match(pattern, typeName); ok {
+			matched = true
+			break
+		}
+	}
+
+	if !matched {
+		return false
+	}
+
+	for k, v := range c.Tags {
+		if tags[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeletionGuardDetail returns the detail of the diagnostic reported when the deletion of a protected resource is prevented.
+func DeletionGuardDetail(typeName, id string) string {
+	return fmt.Sprintf("%s (%s) matches the provider's deletion_guard configuration and cannot be deleted.\n\n"+
+		"To delete it, change the provider's deletion_guard configuration so that the resource no longer matches.", typeName, id)
+}
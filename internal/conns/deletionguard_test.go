@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionGuardConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   *DeletionGuardConfig
+		typeName string
+		tags     map[string]string
+		want     bool
+	}{
+		"nil config": {
+			typeName: "aws_s3_bucket",
+		},
+		"exact match": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"aws_kms_key", "aws_s3_bucket"}},
+			typeName: "aws_s3_bucket",
+			want:     true,
+		},
+		"no match": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"aws_s3_bucket"}},
+			typeName: "aws_s3_bucket_policy",
+		},
+		"glob match": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"aws_db_*"}},
+			typeName: "aws_db_instance",
+			want:     true,
+		},
+		"glob no match": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"aws_db_*"}},
+			typeName: "aws_rds_cluster",
+		},
+		"tags match": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"*"}, Tags: map[string]string{"Protected": "true"}},
+			typeName: "aws_instance",
+			tags:     map[string]string{"Name": "test", "Protected": "true"},
+			want:     true,
+		},
+		"tags value mismatch": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"*"}, Tags: map[string]string{"Protected": "true"}},
+			typeName: "aws_instance",
+			tags:     map[string]string{"Protected": "false"},
+		},
+		"tags missing": {
+			config:   &DeletionGuardConfig{ResourceTypes: []string{"*"}, Tags: map[string]string{"Protected": "true"}},
+			typeName: "aws_instance",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.Protects(testCase.typeName, testCase.tags), testCase.want; got != want {
+				t.Errorf("Protects(%q) = %t, want %t", testCase.typeName, got, want)
+			}
+		})
+	}
+}
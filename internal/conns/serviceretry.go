@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServiceRetryConfig is the retry and rate limit policy for a single service package's API clients.
+// Zero values leave the provider-wide setting in effect.
+type ServiceRetryConfig struct {
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RequestsPerSecond   float64
+	RetryableErrorCodes []string
+}
+
+// serviceRetry applies a ServiceRetryConfig to AWS SDK for Go v1 sessions and v2 configurations.
+// The rate limiter is shared by all of the service's API clients, whatever their AWS Region.
+type serviceRetry struct {
+	config  ServiceRetryConfig
+	limiter *rateLimiter
+}
+
+func newServiceRetry(config ServiceRetryConfig) *serviceRetry {
+	r := &serviceRetry{
+		config: config,
+	}
+
+	if config.RequestsPerSecond > 0 {
+		r.limiter = newRateLimiter(config.RequestsPerSecond)
+	}
+
+	return r
+}
+
+// awsConfig returns a copy of the specified AWS SDK for Go v2 configuration with the policy applied.
+func (r *serviceRetry) awsConfig(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	v := cfg.Copy()
+
+	retryer := v.Retryer
+	v.Retryer = func() aws_sdkv2.Retryer {
+		var rv aws_sdkv2.Retryer
+		if retryer != nil {
+			rv = retryer()
+		} else {
+			rv = retry_sdkv2.NewStandard()
+		}
+
+		if n := r.config.MaxAttempts; n > 0 {
+			rv = retry_sdkv2.AddWithMaxAttempts(rv, n)
+		}
+		if d := r.config.MaxBackoff; d > 0 {
+			rv = retry_sdkv2.AddWithMaxBackoffDelay(rv, d)
+		}
+		if codes := r.config.RetryableErrorCodes; len(codes) > 0 {
+			rv = retry_sdkv2.AddWithErrorCodes(rv, codes...)
+		}
+
+		return rv
+	}
+
+	if r.limiter != nil {
+		// Copy is shallow, don't modify the shared slice.
+		v.APIOptions = append(slices.Clone(v.APIOptions), r.limiter.addMiddleware)
+	}
+
+	return &v
+}
+
+// session returns a copy of the specified AWS SDK for Go v1 session with the policy applied.
+func (r *serviceRetry) session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	config := &aws_sdkv1.Config{}
+
+	maxRetries := aws_sdkv1.IntValue(sess.Config.MaxRetries)
+	if n := r.config.MaxAttempts; n > 0 {
+		maxRetries = n - 1
+		config.MaxRetries = aws_sdkv1.Int(maxRetries)
+	}
+	if d := r.config.MaxBackoff; d > 0 {
+		config.Retryer = client_sdkv1.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    d,
+			MaxThrottleDelay: d,
+		}
+	}
+
+	v := sess.Copy(config)
+
+	if codes := r.config.RetryableErrorCodes; len(codes) > 0 {
+		v.Handlers.Retry.PushBack(func(req *request_sdkv1.Request) {
+			if err, ok := req.Error.(awserr.Error); ok && slices.Contains(codes, err.Code()) {
+				req.Retryable = aws_sdkv1.Bool(true)
+			}
+		})
+	}
+
+	if r.limiter != nil {
+		v.Handlers.Send.PushFront(func(req *request_sdkv1.Request) {
+			if err := r.limiter.wait(req.Context()); err != nil {
+				req.Error = err
+			}
+		})
+	}
+
+	return v
+}
+
+// rateLimiter spaces requests evenly at a maximum rate.
+type rateLimiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     time.Time
+	now      func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	return &rateLimiter{
+		interval: time.Duration(float64(time.Second) / requestsPerSecond),
+		now:      time.Now,
+	}
+}
+
+// reserve returns how long the caller must wait before sending a request.
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	d := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+
+	return d
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Waiting for service rate limit", map[string]any{
+		"tf_aws.service_retry.delay": d.String(),
+	})
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// addMiddleware adds the rate limiter to an AWS SDK for Go v2 middleware stack.
+// Each attempt, including retries, is rate limited.
+func (l *rateLimiter) addMiddleware(stack *middleware.Stack) error {
+	const id = "ServiceRateLimit"
+	m := middleware.FinalizeMiddlewareFunc(id, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(m, "Retry", middleware.After)
+	}
+
+	return stack.Finalize.Add(m, middleware.Before)
+}
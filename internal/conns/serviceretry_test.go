@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+)
+
+func TestServiceRetryAWSConfig(t *testing.T) {
+	t.Parallel()
+
+	base := &aws_sdkv2.Config{}
+	r := newServiceRetry(ServiceRetryConfig{
+		MaxAttempts:         12,
+		MaxBackoff:          time.Minute,
+		RequestsPerSecond:   5,
+		RetryableErrorCodes: []string{"ConcurrentModificationException"},
+	})
+
+	cfg := r.awsConfig(base)
+
+	if base.Retryer != nil || len(base.APIOptions) != 0 {
+		t.Fatal("base configuration modified")
+	}
+
+	retryer := cfg.Retryer()
+
+	if got, want := retryer.MaxAttempts(), 12; got != want {
+		t.Errorf("MaxAttempts = %d, want %d", got, want)
+	}
+	if !retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "ConcurrentModificationException"}) {
+		t.Error("expected ConcurrentModificationException to be retryable")
+	}
+	if retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "ValidationException"}) {
+		t.Error("expected ValidationException not to be retryable")
+	}
+	if got, want := len(cfg.APIOptions), 1; got != want {
+		t.Errorf("len(APIOptions) = %d, want %d", got, want)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	l := newRateLimiter(4)
+	l.now = func() time.Time { return now }
+
+	for i, want := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reserve %d = %s, want %s", i, got, want)
+		}
+	}
+
+	// Unused capacity does not accumulate.
+	now = now.Add(10 * time.Second)
+	for i, want := range []time.Duration{0, 250 * time.Millisecond} {
+		if got := l.reserve(); got != want {
+			t.Errorf("reserve after idle %d = %s, want %s", i, got, want)
+		}
+	}
+}
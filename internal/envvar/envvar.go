@@ -84,6 +84,13 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for running resource sweepers
+const (
+	// The maximum number of sweepers to run concurrently for each service package in each region.
+	// Defaults to 2.
+	SweepServiceConcurrency = "TF_AWS_SWEEP_SERVICE_CONCURRENCY"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -262,6 +263,14 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 		}
 
+		//
+		// fwtypes.ARN or fwtypes.ServiceARN --> arn.ARN
+		//
+		if t, ok := vFrom.(arnValuer); ok && tTo == reflect.TypeOf(arn.ARN{}) {
+			vTo.Set(reflect.ValueOf(t.ValueARN()))
+			return diags
+		}
+
 	case reflect.Ptr:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.String:
@@ -285,6 +294,15 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 				vTo.Set(reflect.ValueOf(&v))
 				return diags
 			}
+
+			//
+			// fwtypes.ARN or fwtypes.ServiceARN --> *arn.ARN
+			//
+			if t, ok := vFrom.(arnValuer); ok && tElem == reflect.TypeOf(arn.ARN{}) {
+				v := t.ValueARN()
+				vTo.Set(reflect.ValueOf(&v))
+				return diags
+			}
 		}
 	}
 
@@ -327,6 +345,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -376,7 +403,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 		switch tSliceElem := vTo.Type().Elem(); tSliceElem.Kind() {
 		case reflect.String:
 			//
-			// types.List(OfString) -> []string.
+			// types.List(OfString) -> []string or []enum.
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -384,7 +411,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceOf(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -525,7 +552,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 		switch tSliceElem := vTo.Type().Elem(); tSliceElem.Kind() {
 		case reflect.String:
 			//
-			// types.Set(OfString) -> []string.
+			// types.Set(OfString) -> []string or []enum.
 			//
 			var to []string
 			diags.Append(vFrom.ElementsAs(ctx, &to, false)...)
@@ -533,7 +560,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSliceOf(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -581,6 +608,13 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// types.List(OfObject) -> union.
+		//
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, tTo, vTo)...)
+		return diags
+
 	case reflect.Map:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -616,6 +650,13 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 				diags.Append(expander.nestedObjectToSlice(ctx, vFrom, tTo, tElem, vTo)...)
 				return diags
 			}
+
+		case reflect.Interface:
+			//
+			// types.List(OfObject) -> []union.
+			//
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
+			return diags
 		}
 	}
 
@@ -732,6 +773,21 @@ func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwt
 	return diags
 }
 
+// stringSliceOf returns a []string as a value of the specified slice type.
+// The slice element type is a string type, such as an AWS SDK for Go v2 enum.
+func stringSliceOf(from []string, tSlice reflect.Type) reflect.Value {
+	if tSlice == reflect.TypeOf(from) {
+		return reflect.ValueOf(from)
+	}
+
+	to := reflect.MakeSlice(tSlice, len(from), len(from))
+	for i, v := range from {
+		to.Index(i).SetString(v)
+	}
+
+	return to
+}
+
 // blockKeyMap takes a struct and extracts the value of the `key`
 func blockKeyMap(from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,6 +28,7 @@ func TestExpand(t *testing.T) {
 	testStringResult := "a"
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
+	testARNValue := errs.Must(arn.Parse(testARN))
 
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
@@ -269,6 +271,24 @@ func TestExpand(t *testing.T) {
 			Target:     &TestFlexAWS02{},
 			WantTarget: &TestFlexAWS02{Field1: aws.String(testARN)},
 		},
+		{
+			TestName:   "single ARN Source and single arn.ARN Target",
+			Source:     &TestFlexTF17{Field1: fwtypes.ARNValueMust(testARN)},
+			Target:     &TestFlexARNAWS01{},
+			WantTarget: &TestFlexARNAWS01{Field1: testARNValue},
+		},
+		{
+			TestName:   "single ARN Source and single *arn.ARN Target",
+			Source:     &TestFlexTF17{Field1: fwtypes.ARNValueMust(testARN)},
+			Target:     &TestFlexARNAWS02{},
+			WantTarget: &TestFlexARNAWS02{Field1: &testARNValue},
+		},
+		{
+			TestName:   "single ServiceARN Source and single arn.ARN Target",
+			Source:     &TestFlexTF19{Field1: fwtypes.ARNOf("securityhub", "control").Value(testARN)},
+			Target:     &TestFlexARNAWS01{},
+			WantTarget: &TestFlexARNAWS01{Field1: testARNValue},
+		},
 		{
 			TestName: "timestamp pointer",
 			Source: &TestFlexTimeTF01{
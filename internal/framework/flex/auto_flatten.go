@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -87,8 +88,17 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Struct:
+		if vFrom.Type() == reflect.TypeOf(arn.ARN{}) {
+			diags.Append(flattener.arn(ctx, vFrom, false, tTo, vTo)...)
+			return diags
+		}
+
 		diags.Append(flattener.struct_(ctx, vFrom, false, tTo, vTo)...)
 		return diags
+
+	case reflect.Interface:
+		diags.Append(flattener.union(ctx, vFrom, tTo, vTo)...)
+		return diags
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -226,6 +236,37 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 	return diags
 }
 
+// arn copies an AWS API arn.ARN value to a compatible Plugin Framework value.
+func (flattener autoFlattener) arn(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := tTo.(type) {
+	case basetypes.StringTypable:
+		stringValue := types.StringNull()
+		if !isNullFrom {
+			stringValue = types.StringValue(vFrom.Interface().(arn.ARN).String())
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		//
+		// arn.ARN -> fwtypes.ARN, fwtypes.ServiceARN or types.String.
+		//
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
 func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, isNullFrom bool, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -286,6 +327,11 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 		return diags
 
 	case reflect.Struct:
+		if vFrom.Type().Elem() == reflect.TypeOf(arn.ARN{}) {
+			diags.Append(flattener.arn(ctx, vElem, isNilFrom, tTo, vTo)...)
+			return diags
+		}
+
 		diags.Append(flattener.struct_(ctx, vElem, isNilFrom, tTo, vTo)...)
 		return diags
 	}
@@ -494,6 +540,15 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 			diags.Append(flattener.sliceOfStructNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
+
+	case reflect.Interface:
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []union -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -28,6 +29,7 @@ func TestFlatten(t *testing.T) {
 	testString := "test"
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
+	testARNValue := errs.Must(arn.Parse(testARN))
 
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
@@ -390,6 +392,24 @@ func TestFlatten(t *testing.T) {
 			Target:     &TestFlexTF17{},
 			WantTarget: &TestFlexTF17{Field1: fwtypes.ARNNull()},
 		},
+		{
+			TestName:   "single arn.ARN Source and single ARN Target",
+			Source:     &TestFlexARNAWS01{Field1: testARNValue},
+			Target:     &TestFlexTF17{},
+			WantTarget: &TestFlexTF17{Field1: fwtypes.ARNValueMust(testARN)},
+		},
+		{
+			TestName:   "single *arn.ARN Source and single ARN Target",
+			Source:     &TestFlexARNAWS02{Field1: &testARNValue},
+			Target:     &TestFlexTF17{},
+			WantTarget: &TestFlexTF17{Field1: fwtypes.ARNValueMust(testARN)},
+		},
+		{
+			TestName:   "single nil *arn.ARN Source and single ARN Target",
+			Source:     &TestFlexARNAWS02{},
+			Target:     &TestFlexTF17{},
+			WantTarget: &TestFlexTF17{Field1: fwtypes.ARNNull()},
+		},
 		{
 			TestName: "timestamp pointer",
 			Source: &TestFlexTimeAWS01{
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -29,6 +30,11 @@ type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
 }
 
+// arnValuer is the interface implemented by Plugin Framework ARN values, such as fwtypes.ARN and fwtypes.ServiceARN.
+type arnValuer interface {
+	ValueARN() arn.ARN
+}
+
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(autoFlexer)
 
@@ -6,6 +6,7 @@ package flex
 import (
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -268,6 +269,18 @@ type TestFlexTF17 struct {
 	Field1 fwtypes.ARN `tfsdk:"field1"`
 }
 
+type TestFlexTF19 struct {
+	Field1 fwtypes.ServiceARN `tfsdk:"field1"`
+}
+
+type TestFlexARNAWS01 struct {
+	Field1 arn.ARN
+}
+
+type TestFlexARNAWS02 struct {
+	Field1 *arn.ARN
+}
+
 // List/Set/Map of string types.
 type TestFlexTF18 struct {
 	Field1 fwtypes.ListValueOf[types.String] `tfsdk:"field1"`
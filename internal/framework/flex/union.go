@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// AWS SDK for Go v2 union types are interfaces implemented by a set of member structs,
+// each with a single `Value` field, e.g.
+//
+//	type APISchema interface { isAPISchema() }
+//	type APISchemaMemberPayload struct { Value string }
+//	type APISchemaMemberS3 struct { Value S3Identifier }
+//
+// The Terraform representation of a union is a nested object with one attribute or block per member,
+// at most one of which is set, e.g.
+//
+//	type apiSchemaModel struct {
+//		Payload types.String                                   `tfsdk:"payload"`
+//		S3      fwtypes.ListNestedObjectValueOf[s3IdentifierModel] `tfsdk:"s3"`
+//	}
+//
+// Go reflection can't enumerate the implementations of an interface so union member types must be
+// registered via RegisterUnion. Member names are taken from the member type name following "Member".
+// A field's member name can be overridden with the `autoflex` struct tag, e.g. `autoflex:"Payload"`.
+
+const (
+	unionMemberSeparator = "Member"
+	unionMemberTagKey    = "autoflex"
+)
+
+type unionMember struct {
+	name string
+	typ  reflect.Type // Pointer to member struct.
+}
+
+var (
+	unionsLock sync.RWMutex
+	unions     = make(map[reflect.Type][]unionMember)
+)
+
+// RegisterUnion registers the member types of the AWS SDK for Go v2 union interface type T.
+// Members are passed as pointers to (empty) member structs, e.g.
+//
+//	flex.RegisterUnion[awstypes.APISchema](&awstypes.APISchemaMemberPayload{}, &awstypes.APISchemaMemberS3{})
+func RegisterUnion[T any](members ...T) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("RegisterUnion: %s is not an interface type", typ))
+	}
+
+	unionsLock.Lock()
+	defer unionsLock.Unlock()
+
+	for _, member := range members {
+		t := reflect.TypeOf(member)
+		unions[typ] = append(unions[typ], unionMember{
+			name: unionMemberName(t),
+			typ:  t,
+		})
+	}
+}
+
+func unionMembers(typ reflect.Type) ([]unionMember, bool) {
+	unionsLock.RLock()
+	defer unionsLock.RUnlock()
+
+	members, ok := unions[typ]
+
+	return members, ok
+}
+
+// unionMemberName returns the member name of a union member type, e.g. `S3` for `*APISchemaMemberS3`.
+func unionMemberName(typ reflect.Type) string {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	name := typ.Name()
+	if i := strings.LastIndex(name, unionMemberSeparator); i >= 0 {
+		return name[i+len(unionMemberSeparator):]
+	}
+
+	return name
+}
+
+// unionFieldMemberName returns the member name that a Terraform struct field maps to.
+func unionFieldMemberName(field reflect.StructField) string {
+	if v := field.Tag.Get(unionMemberTagKey); v != "" {
+		return v
+	}
+
+	return field.Name
+}
+
+// unionFieldAttributeName returns the Terraform attribute or block name of a struct field, used in diagnostics.
+func unionFieldAttributeName(field reflect.StructField) string {
+	if v, _, _ := strings.Cut(field.Tag.Get("tfsdk"), ","); v != "" && v != "-" {
+		return v
+	}
+
+	return field.Name
+}
+
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union interface value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, tUnion reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	to, d := expander.union(ctx, from, tUnion)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		to, d := expander.union(ctx, f.Index(i).Interface(), tSlice.Elem())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.IsValid() {
+			t = reflect.Append(t, to)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// union returns the AWS API union member corresponding to the single set field of the Terraform struct pointer `from`.
+// An invalid value is returned if no field is set.
+func (expander autoExpander) union(ctx context.Context, from any, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members, ok := unionMembers(tUnion)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union type %s is not registered", tUnion))
+		return reflect.Value{}, diags
+	}
+
+	valFrom := reflect.ValueOf(from)
+	if valFrom.Kind() == reflect.Ptr {
+		valFrom = valFrom.Elem()
+	}
+
+	var (
+		set   []string
+		field reflect.StructField
+	)
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		f := typFrom.Field(i)
+		if f.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		v, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		set = append(set, unionFieldAttributeName(f))
+		field = f
+	}
+
+	switch len(set) {
+	case 0:
+		return reflect.Value{}, diags
+	case 1:
+	default:
+		diags.AddError("AutoFlEx", fmt.Sprintf("union %s: only one of %s can be set, got %s", tUnion, strings.Join(unionAttributeNames(valFrom.Type()), ", "), strings.Join(set, ", ")))
+		return reflect.Value{}, diags
+	}
+
+	name := unionFieldMemberName(field)
+	for _, member := range members {
+		if !strings.EqualFold(member.name, name) {
+			continue
+		}
+
+		to := reflect.New(member.typ.Elem())
+		diags.Append(expander.convert(ctx, valFrom.FieldByIndex(field.Index), to.Elem().FieldByName("Value"))...)
+		if diags.HasError() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.Name))
+			return reflect.Value{}, diags
+		}
+
+		return to, diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("union %s: no member corresponds to %s", tUnion, set[0]))
+	return reflect.Value{}, diags
+}
+
+func unionAttributeNames(typ reflect.Type) []string {
+	var names []string
+
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.PkgPath == "" {
+			names = append(names, unionFieldAttributeName(field))
+		}
+	}
+
+	return names
+}
+
+// union copies an AWS API union interface value to a compatible Plugin Framework value.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(flattener.unionToNestedObject(ctx, vFrom, tTo, vTo)...)
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Kind(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
+// unionToNestedObject copies an AWS API union value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	to, d := flattener.unionToObjectPtr(ctx, vFrom, tTo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var val attr.Value
+	if to == nil {
+		val, d = tTo.NullValue(ctx)
+	} else {
+		val, d = tTo.ValueFromObjectPtr(ctx, to)
+	}
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, 0, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := flattener.unionToObjectPtr(ctx, vFrom.Index(i), tTo)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if target != nil {
+			t = reflect.Append(t, reflect.ValueOf(target))
+		}
+	}
+
+	val, d := tTo.ValueFromObjectSlice(ctx, t.Interface())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// unionToObjectPtr returns a new Terraform struct pointer with the field corresponding to the union member `vFrom` set
+// and all other fields null.
+// nil is returned if `vFrom` is nil or is a member unknown to this version of the AWS SDK.
+func (flattener autoFlattener) unionToObjectPtr(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		return nil, diags
+	}
+
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Ptr {
+		vMember = vMember.Elem()
+	}
+
+	vValue := vMember.FieldByName("Value")
+	if name := vMember.Type().Name(); !vValue.IsValid() || name == "UnknownUnionMember" {
+		tflog.Warn(ctx, "AutoFlex Flatten; unsupported union member", map[string]interface{}{
+			"from": vFrom.Elem().Type(),
+		})
+
+		return nil, diags
+	}
+
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	valTo := reflect.ValueOf(to).Elem()
+	name := unionMemberName(vMember.Type())
+	var found bool
+
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+
+		vField := valTo.Field(i)
+
+		if !found && strings.EqualFold(unionFieldMemberName(field), name) {
+			found = true
+
+			diags.Append(flattener.convert(ctx, vValue, vField)...)
+			if diags.HasError() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", field.Name))
+				return nil, diags
+			}
+
+			continue
+		}
+
+		diags.Append(setNull(ctx, vField)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if !found {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union member %s: no corresponding field in %s", vFrom.Elem().Type(), valTo.Type()))
+		return nil, diags
+	}
+
+	return to, diags
+}
+
+// setNull sets a Plugin Framework value to the null value of its type.
+func setNull(ctx context.Context, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		return diags
+	}
+
+	typ := v.Type(ctx)
+	null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("null value (%s): %s", typ, err))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(null))
+	return diags
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type TestUnion interface {
+	isTestUnion()
+}
+
+type TestUnionMemberName struct {
+	Value string
+}
+
+func (*TestUnionMemberName) isTestUnion() {}
+
+type TestUnionMemberNested struct {
+	Value TestFlexAWS01
+}
+
+func (*TestUnionMemberNested) isTestUnion() {}
+
+type TestUnionMemberEnum struct {
+	Value TestEnum
+}
+
+func (*TestUnionMemberEnum) isTestUnion() {}
+
+type TestUnionTF struct {
+	Name   types.String                                  `tfsdk:"name"`
+	Nested fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"nested"`
+	Shape  fwtypes.StringEnum[TestEnum]                  `tfsdk:"shape" autoflex:"Enum"`
+}
+
+type TestUnionOuterTF struct {
+	Union  fwtypes.ListNestedObjectValueOf[TestUnionTF] `tfsdk:"union"`
+	Unions fwtypes.ListNestedObjectValueOf[TestUnionTF] `tfsdk:"unions"`
+}
+
+type TestUnionOuterAWS struct {
+	Union  TestUnion
+	Unions []TestUnion
+}
+
+type TestEnumListTF struct {
+	Field1 fwtypes.ListValueOf[fwtypes.StringEnum[TestEnum]] `tfsdk:"field1"`
+	Field2 fwtypes.SetValueOf[fwtypes.StringEnum[TestEnum]]  `tfsdk:"field2"`
+}
+
+type TestEnumListAWS struct {
+	Field1 []TestEnum
+	Field2 []TestEnum
+}
+
+func init() {
+	RegisterUnion[TestUnion](&TestUnionMemberName{}, &TestUnionMemberNested{}, &TestUnionMemberEnum{})
+}
+
+func testUnionTFNull() TestUnionTF {
+	return TestUnionTF{
+		Name:   types.StringNull(),
+		Nested: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](context.Background()),
+		Shape:  fwtypes.StringEnumNull[TestEnum](),
+	}
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	name := testUnionTFNull()
+	name.Name = types.StringValue("a")
+	nested := testUnionTFNull()
+	nested.Nested = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")})
+	shape := testUnionTFNull()
+	shape.Shape = fwtypes.StringEnumValue(TestEnumList)
+	both := testUnionTFNull()
+	both.Name = types.StringValue("a")
+	both.Shape = fwtypes.StringEnumValue(TestEnumList)
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "null union",
+			Source: &TestUnionOuterTF{
+				Union:  fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+				Unions: fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+			},
+			Target:     &TestUnionOuterAWS{},
+			WantTarget: &TestUnionOuterAWS{},
+		},
+		{
+			TestName: "single union members",
+			Source: &TestUnionOuterTF{
+				Union:  fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &nested),
+				Unions: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestUnionTF{name, shape}),
+			},
+			Target: &TestUnionOuterAWS{},
+			WantTarget: &TestUnionOuterAWS{
+				Union:  &TestUnionMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+				Unions: []TestUnion{&TestUnionMemberName{Value: "a"}, &TestUnionMemberEnum{Value: TestEnumList}},
+			},
+		},
+		{
+			TestName: "multiple union members",
+			Source: &TestUnionOuterTF{
+				Union:  fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &both),
+				Unions: fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+			},
+			Target:  &TestUnionOuterAWS{},
+			WantErr: true,
+		},
+	}
+
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandUnionMultipleMembersError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	both := testUnionTFNull()
+	both.Name = types.StringValue("a")
+	both.Shape = fwtypes.StringEnumValue(TestEnumList)
+
+	diags := Expand(ctx, &TestUnionOuterTF{
+		Union:  fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &both),
+		Unions: fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+	}, &TestUnionOuterAWS{})
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := diags[0].Detail(), "only one of name, nested, shape can be set, got name, shape"; !strings.Contains(got, want) {
+		t.Errorf("error detail = %q, want to contain %q", got, want)
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	name := testUnionTFNull()
+	name.Name = types.StringValue("a")
+	nested := testUnionTFNull()
+	nested.Nested = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")})
+	shape := testUnionTFNull()
+	shape.Shape = fwtypes.StringEnumValue(TestEnumList)
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "nil union",
+			Source:   &TestUnionOuterAWS{},
+			Target:   &TestUnionOuterTF{},
+			WantTarget: &TestUnionOuterTF{
+				Union:  fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+				Unions: fwtypes.NewListNestedObjectValueOfNull[TestUnionTF](ctx),
+			},
+		},
+		{
+			TestName: "union members",
+			Source: &TestUnionOuterAWS{
+				Union:  &TestUnionMemberNested{Value: TestFlexAWS01{Field1: "b"}},
+				Unions: []TestUnion{&TestUnionMemberName{Value: "a"}, &TestUnionMemberEnum{Value: TestEnumList}},
+			},
+			Target: &TestUnionOuterTF{},
+			WantTarget: &TestUnionOuterTF{
+				Union:  fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &nested),
+				Unions: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestUnionTF{name, shape}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestExpandEnumList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		{
+			TestName: "enum list and set",
+			Source: &TestEnumListTF{
+				Field1: fwtypes.NewListValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{fwtypes.StringEnumValue(TestEnumList), fwtypes.StringEnumValue(TestEnumScalar)}),
+				Field2: fwtypes.NewSetValueOfMust[fwtypes.StringEnum[TestEnum]](ctx, []attr.Value{fwtypes.StringEnumValue(TestEnumScalar)}),
+			},
+			Target: &TestEnumListAWS{},
+			WantTarget: &TestEnumListAWS{
+				Field1: []TestEnum{TestEnumList, TestEnumScalar},
+				Field2: []TestEnum{TestEnumScalar},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(ctx, t, testCases)
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportIDPart describes a single part of a composite import ID.
+type ImportIDPart struct {
+	// Attribute is the name of the top-level attribute that the part's value is set on.
+	Attribute string
+	// Optional parts may be empty. Trailing optional parts may be omitted entirely.
+	Optional bool
+	// Remainder is set if the part receives the remainder of the import ID, including any separators,
+	// e.g. an ARN or CIDR block containing the separator. Only the last part may be a remainder part.
+	Remainder bool
+	// Validators are run against any non-empty part value.
+	Validators []ImportIDPartValidator
+}
+
+// ImportIDPartValidator validates a single import ID part value.
+type ImportIDPartValidator func(string) error
+
+// ImportIDPartARN validates that an import ID part is an ARN.
+func ImportIDPartARN(s string) error {
+	if !arn.IsARN(s) {
+		return fmt.Errorf("%q is not a valid ARN", s)
+	}
+
+	return nil
+}
+
+// ImportIDPartAccountID validates that an import ID part is an AWS account ID.
+func ImportIDPartAccountID(s string) error {
+	if !itypes.IsAWSAccountID(s) {
+		return fmt.Errorf("%q is not a valid AWS account ID", s)
+	}
+
+	return nil
+}
+
+// ImportIDPartCIDRBlock validates that an import ID part is a CIDR block.
+func ImportIDPartCIDRBlock(s string) error {
+	return itypes.ValidateCIDRBlock(s)
+}
+
+// ImportIDParser parses composite import IDs made up of parts joined by a separator.
+// An import ID must have no more parts than the parser, unless the last part is a remainder part,
+// which receives the rest of the ID including any separators.
+type ImportIDParser struct {
+	Parts     []ImportIDPart
+	Separator string
+}
+
+// Format returns a description of the expected import ID format, e.g. `name,type[,region]`.
+func (p ImportIDParser) Format() string {
+	var sb strings.Builder
+
+	for i, part := range p.Parts {
+		if part.Optional {
+			sb.WriteString("[")
+		}
+		if i > 0 {
+			sb.WriteString(p.Separator)
+		}
+		sb.WriteString(part.Attribute)
+		if part.Optional {
+			sb.WriteString("]")
+		}
+	}
+
+	return sb.String()
+}
+
+// Validate returns an error diagnostic if the parser's parts can never be parsed with its separator.
+func (p ImportIDParser) Validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if p.Separator == "" {
+		diags.AddError("Invalid Import ID Parts", "import ID separator must not be empty")
+	}
+
+	for i, part := range p.Parts {
+		if part.Remainder && i != len(p.Parts)-1 {
+			diags.AddError("Invalid Import ID Parts", fmt.Sprintf("import ID part %s receives the remainder of the ID and must be the last part", part.Attribute))
+		}
+	}
+
+	return diags
+}
+
+// Parse splits the specified import ID into its parts, validating each.
+// The returned slice always has one element per part; omitted optional parts are empty.
+func (p ImportIDParser) Parse(id string) ([]string, error) {
+	minParts := 0
+	for i, part := range p.Parts {
+		if !part.Optional {
+			minParts = i + 1
+		}
+	}
+
+	var values []string
+	if n := len(p.Parts); n > 0 && p.Parts[n-1].Remainder {
+		values = strings.SplitN(id, p.Separator, n)
+	} else {
+		values = strings.Split(id, p.Separator)
+	}
+
+	if n := len(values); n < minParts || n > len(p.Parts) {
+		return nil, p.formatError(id)
+	}
+
+	for i, value := range values {
+		part := p.Parts[i]
+
+		if value == "" {
+			if !part.Optional {
+				return nil, p.formatError(id)
+			}
+
+			continue
+		}
+
+		for _, v := range part.Validators {
+			if err := v(value); err != nil {
+				return nil, fmt.Errorf("invalid %s in import ID (%s): %w", part.Attribute, id, err)
+			}
+		}
+	}
+
+	return append(values, make([]string, len(p.Parts)-len(values))...), nil
+}
+
+// ImportState sets each non-empty import ID part on its attribute.
+func (p ImportIDParser) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(p.Validate()...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	values, err := p.Parse(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+
+		return
+	}
+
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(p.Parts[i].Attribute), value)...)
+	}
+}
+
+func (p ImportIDParser) formatError(id string) error {
+	return fmt.Errorf("unexpected format for import ID (%s), expected %q", id, p.Format())
+}
+
+// WithImportByParts is intended to be embedded in resources which import state via a composite ID.
+// Call SetImportIDParts from the resource's constructor to describe the ID.
+type WithImportByParts struct {
+	importIDParser ImportIDParser
+}
+
+// SetImportIDParts sets the separator and parts of the resource's composite import ID.
+// Only the last part may be a remainder part, which may contain the separator.
+// Invalid parts are reported as error diagnostics on import.
+func (w *WithImportByParts) SetImportIDParts(separator string, parts ...ImportIDPart) {
+	w.importIDParser = ImportIDParser{
+		Parts:     parts,
+		Separator: separator,
+	}
+}
+
+func (w *WithImportByParts) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	w.importIDParser.ImportState(ctx, request, response)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportIDParserFormat(t *testing.T) {
+	t.Parallel()
+
+	p := ImportIDParser{
+		Parts: []ImportIDPart{
+			{Attribute: "name"},
+			{Attribute: "type"},
+			{Attribute: "region", Optional: true},
+		},
+		Separator: ",",
+	}
+
+	if got, expected := p.Format(), "name,type[,region]"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestImportIDParserParse(t *testing.T) {
+	t.Parallel()
+
+	p := ImportIDParser{
+		Parts: []ImportIDPart{
+			{Attribute: "account_id", Validators: []ImportIDPartValidator{ImportIDPartAccountID}},
+			{Attribute: "type", Optional: true},
+			{Attribute: "name"},
+			{Attribute: "arn", Optional: true, Remainder: true, Validators: []ImportIDPartValidator{ImportIDPartARN}},
+		},
+		Separator: "/",
+	}
+
+	testCases := map[string]struct {
+		id          string
+		expected    []string
+		expectError bool
+	}{
+		"all parts": {
+			id:       "123456789012//name/arn:aws:s3:::bucket",
+			expected: []string{"123456789012", "", "name", "arn:aws:s3:::bucket"},
+		},
+		"trailing optional part omitted": {
+			id:       "123456789012//name",
+			expected: []string{"123456789012", "", "name", ""},
+		},
+		"trailing optional part empty": {
+			id:       "123456789012//name/",
+			expected: []string{"123456789012", "", "name", ""},
+		},
+		"separator in last part value": {
+			id:       "123456789012/type/name/arn:aws:iam::123456789012:role/path/name",
+			expected: []string{"123456789012", "type", "name", "arn:aws:iam::123456789012:role/path/name"},
+		},
+		"too few parts": {
+			id:          "123456789012",
+			expectError: true,
+		},
+		"extra parts in last part value": {
+			id:          "123456789012//name/bucket/extra",
+			expectError: true,
+		},
+		"required part empty": {
+			id:          "123456789012///arn:aws:s3:::bucket",
+			expectError: true,
+		},
+		"invalid account ID": {
+			id:          "1234//name",
+			expectError: true,
+		},
+		"invalid ARN": {
+			id:          "123456789012//name/bucket",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := p.Parse(testCase.id)
+
+			if err != nil {
+				if !testCase.expectError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if testCase.expectError {
+				t.Fatal("expected error")
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestImportIDParserParseCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	p := ImportIDParser{
+		Parts: []ImportIDPart{
+			{Attribute: "vpc_id"},
+			{Attribute: "cidr_block", Remainder: true, Validators: []ImportIDPartValidator{ImportIDPartCIDRBlock}},
+		},
+		Separator: "/",
+	}
+
+	got, err := p.Parse("vpc-12345678/10.0.0.0/16")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []string{"vpc-12345678", "10.0.0.0/16"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := p.Parse("vpc-12345678/10.0.0.1/16"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestImportIDParserParseStrict(t *testing.T) {
+	t.Parallel()
+
+	p := ImportIDParser{
+		Parts: []ImportIDPart{
+			{Attribute: "environment_id"},
+			{Attribute: "application_id"},
+		},
+		Separator: ":",
+	}
+
+	got, err := p.Parse("env:app")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, []string{"env", "app"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := p.Parse("env:app:extra"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestImportIDParserValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		separator   string
+		parts       []ImportIDPart
+		expectError bool
+	}{
+		"remainder last": {
+			separator: ":",
+			parts: []ImportIDPart{
+				{Attribute: "name"},
+				{Attribute: "arn", Remainder: true, Validators: []ImportIDPartValidator{ImportIDPartARN}},
+			},
+		},
+		"remainder not last": {
+			separator: ":",
+			parts: []ImportIDPart{
+				{Attribute: "arn", Remainder: true, Validators: []ImportIDPartValidator{ImportIDPartARN}},
+				{Attribute: "name"},
+			},
+			expectError: true,
+		},
+		"no remainder": {
+			separator: ",",
+			parts: []ImportIDPart{
+				{Attribute: "arn", Validators: []ImportIDPartValidator{ImportIDPartARN}},
+				{Attribute: "name"},
+			},
+		},
+		"empty separator": {
+			parts: []ImportIDPart{
+				{Attribute: "name"},
+				{Attribute: "type"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := ImportIDParser{Parts: testCase.parts, Separator: testCase.separator}.Validate()
+
+			if got, expected := diags.HasError(), testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error %t", diags, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ provider.Provider = (*schemaProvider)(nil)
+)
+
+// schemaProvider is a minimal Plugin Framework provider used only to obtain resource and data source schemas.
+type schemaProvider struct {
+	dataSources []func() datasource.DataSource
+	resources   []func() resource.Resource
+}
+
+func (p *schemaProvider) Metadata(ctx context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = providerTypeName
+}
+
+func (p *schemaProvider) Schema(ctx context.Context, request provider.SchemaRequest, response *provider.SchemaResponse) {
+	response.Schema = schema.Schema{}
+}
+
+func (p *schemaProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+}
+
+func (p *schemaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return p.dataSources
+}
+
+func (p *schemaProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return p.resources
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	providerTypeName = "aws"
+)
+
+// ResourceSchemaDiffs returns the differences between the Terraform schema of a Plugin SDKv2 resource
+// and the schema of the Plugin Framework resource it has been migrated to.
+// An empty result means that the two schemas are identical as seen by Terraform, with the following exceptions:
+//
+//   - The Plugin SDK marks an implicit top-level `id` attribute as Optional. The migrated attribute need only be Computed.
+//   - The Plugin Framework does not send block MinItems or MaxItems to Terraform; they are enforced by validators instead.
+//   - Descriptions are documentation only and are not compared.
+func ResourceSchemaDiffs(ctx context.Context, sdkv2Resource *schema.Resource, fwResource resource.Resource) ([]string, error) {
+	var response resource.MetadataResponse
+	fwResource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &response)
+	typeName := response.TypeName
+
+	sdkv2Schema, err := sdkv2ProviderSchema(ctx, &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			typeName: sdkv2Resource,
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	fwSchema, err := fwProviderSchema(ctx, &schemaProvider{
+		resources: []func() resource.Resource{
+			func() resource.Resource { return fwResource },
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, implicitID := sdkv2Resource.Schema["id"]
+	implicitID = !implicitID
+
+	return schemaDiffs(sdkv2Schema.ResourceSchemas[typeName], fwSchema.ResourceSchemas[typeName], implicitID), nil
+}
+
+// DataSourceSchemaDiffs returns the differences between the Terraform schema of a Plugin SDKv2 data source
+// and the schema of the Plugin Framework data source it has been migrated to.
+// See ResourceSchemaDiffs.
+func DataSourceSchemaDiffs(ctx context.Context, sdkv2DataSource *schema.Resource, fwDataSource datasource.DataSource) ([]string, error) {
+	var response datasource.MetadataResponse
+	fwDataSource.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: providerTypeName}, &response)
+	typeName := response.TypeName
+
+	sdkv2Schema, err := sdkv2ProviderSchema(ctx, &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			typeName: sdkv2DataSource,
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	fwSchema, err := fwProviderSchema(ctx, &schemaProvider{
+		dataSources: []func() datasource.DataSource{
+			func() datasource.DataSource { return fwDataSource },
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Plugin SDK data sources' implicit `id` attributes are Optional and Computed, as are migrated ones.
+	return schemaDiffs(sdkv2Schema.DataSourceSchemas[typeName], fwSchema.DataSourceSchemas[typeName], false), nil
+}
+
+func sdkv2ProviderSchema(ctx context.Context, p *schema.Provider) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := schema.NewGRPCProviderServer(p).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := diagnosticsError(response.Diagnostics); err != nil {
+		return nil, fmt.Errorf("Plugin SDK schema: %w", err)
+	}
+
+	return response, nil
+}
+
+func fwProviderSchema(ctx context.Context, p provider.Provider) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := providerserver.NewProtocol5(p)().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := diagnosticsError(response.Diagnostics); err != nil {
+		return nil, fmt.Errorf("Plugin Framework schema: %w", err)
+	}
+
+	return response, nil
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, v := range diags {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", v.Summary, v.Detail))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func schemaDiffs(sdkv2, fw *tfprotov5.Schema, implicitID bool) []string {
+	var diffs []string
+
+	if sdkv2 == nil || fw == nil {
+		return []string{fmt.Sprintf("schema: Plugin SDK %t, Plugin Framework %t", sdkv2 != nil, fw != nil)}
+	}
+
+	if sdkv2.Version != fw.Version {
+		diffs = append(diffs, fmt.Sprintf("schema version: Plugin SDK %d, Plugin Framework %d", sdkv2.Version, fw.Version))
+	}
+
+	return append(diffs, blockDiffs(nil, sdkv2.Block, fw.Block, implicitID)...)
+}
+
+func blockDiffs(path []string, sdkv2, fw *tfprotov5.SchemaBlock, implicitID bool) []string {
+	var diffs []string
+
+	diff := func(property string, sdkv2, fw any) {
+		if sdkv2 != fw {
+			diffs = append(diffs, fmt.Sprintf("block %s %s: Plugin SDK %v, Plugin Framework %v", pathString(path), property, sdkv2, fw))
+		}
+	}
+
+	diff("deprecated", sdkv2.Deprecated, fw.Deprecated)
+
+	sdkv2Attributes, fwAttributes := make(map[string]*tfprotov5.SchemaAttribute), make(map[string]*tfprotov5.SchemaAttribute)
+	for _, v := range sdkv2.Attributes {
+		sdkv2Attributes[v.Name] = v
+	}
+	for _, v := range fw.Attributes {
+		fwAttributes[v.Name] = v
+	}
+
+	for _, name := range names(sdkv2Attributes, fwAttributes) {
+		path := append(slices.Clip(path), name)
+		sdkv2, fw := sdkv2Attributes[name], fwAttributes[name]
+
+		if sdkv2 == nil || fw == nil {
+			diffs = append(diffs, fmt.Sprintf("attribute %s: Plugin SDK %t, Plugin Framework %t", pathString(path), sdkv2 != nil, fw != nil))
+			continue
+		}
+
+		diff := func(property string, sdkv2, fw any) {
+			if sdkv2 != fw {
+				diffs = append(diffs, fmt.Sprintf("attribute %s %s: Plugin SDK %v, Plugin Framework %v", pathString(path), property, sdkv2, fw))
+			}
+		}
+
+		if !sdkv2.Type.Equal(fw.Type) {
+			diffs = append(diffs, fmt.Sprintf("attribute %s type: Plugin SDK %s, Plugin Framework %s", pathString(path), sdkv2.Type, fw.Type))
+		}
+		diff("required", sdkv2.Required, fw.Required)
+		if !(implicitID && len(path) == 1 && name == "id") {
+			diff("optional", sdkv2.Optional, fw.Optional)
+		}
+		diff("computed", sdkv2.Computed, fw.Computed)
+		diff("sensitive", sdkv2.Sensitive, fw.Sensitive)
+		diff("deprecated", sdkv2.Deprecated, fw.Deprecated)
+	}
+
+	sdkv2Blocks, fwBlocks := make(map[string]*tfprotov5.SchemaNestedBlock), make(map[string]*tfprotov5.SchemaNestedBlock)
+	for _, v := range sdkv2.BlockTypes {
+		sdkv2Blocks[v.TypeName] = v
+	}
+	for _, v := range fw.BlockTypes {
+		fwBlocks[v.TypeName] = v
+	}
+
+	for _, name := range names(sdkv2Blocks, fwBlocks) {
+		path := append(slices.Clip(path), name)
+		sdkv2, fw := sdkv2Blocks[name], fwBlocks[name]
+
+		if sdkv2 == nil || fw == nil {
+			diffs = append(diffs, fmt.Sprintf("block %s: Plugin SDK %t, Plugin Framework %t", pathString(path), sdkv2 != nil, fw != nil))
+			continue
+		}
+
+		if sdkv2.Nesting != fw.Nesting {
+			diffs = append(diffs, fmt.Sprintf("block %s nesting: Plugin SDK %s, Plugin Framework %s", pathString(path), sdkv2.Nesting, fw.Nesting))
+		}
+
+		diffs = append(diffs, blockDiffs(path, sdkv2.Block, fw.Block, false)...)
+	}
+
+	return diffs
+}
+
+// names returns the sorted union of the keys of the specified maps.
+func names[V any](m1, m2 map[string]V) []string {
+	var names []string
+
+	for k := range m1 {
+		names = append(names, k)
+	}
+	for k := range m2 {
+		if _, ok := m1[k]; !ok {
+			names = append(names, k)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func pathString(path []string) string {
+	if len(path) == 0 {
+		return "(root)"
+	}
+
+	return strings.Join(path, ".")
+}
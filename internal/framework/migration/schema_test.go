@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSchemaDiffs(t *testing.T) {
+	t.Parallel()
+
+	sdkv2Resource := func() *schema.Resource {
+		return &schema.Resource{
+			Timeouts: &schema.ResourceTimeout{
+				Create: schema.DefaultTimeout(10 * time.Minute),
+				Delete: schema.DefaultTimeout(10 * time.Minute),
+			},
+			Schema: map[string]*schema.Schema{
+				"arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"size": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"subnet_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		}
+	}
+
+	fwSchema := func(ctx context.Context) fwschema.Schema {
+		return fwschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"arn": fwschema.StringAttribute{
+					Computed: true,
+				},
+				"id": fwschema.StringAttribute{
+					Computed: true,
+				},
+				"name": fwschema.StringAttribute{
+					Required: true,
+				},
+				"size": fwschema.Int64Attribute{
+					Optional: true,
+				},
+				"subnet_ids": fwschema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]fwschema.Block{
+				"configuration": fwschema.ListNestedBlock{
+					NestedObject: fwschema.NestedBlockObject{
+						Attributes: map[string]fwschema.Attribute{
+							"enabled": fwschema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"timeouts": timeouts.Block(ctx, timeouts.Opts{
+					Create: true,
+					Delete: true,
+				}),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		sdkv2    func() *schema.Resource
+		fw       func(context.Context) fwschema.Schema
+		expected []string
+	}{
+		"equivalent": {
+			sdkv2: sdkv2Resource,
+			fw:    fwSchema,
+		},
+		"differences": {
+			sdkv2: sdkv2Resource,
+			fw: func(ctx context.Context) fwschema.Schema {
+				s := fwSchema(ctx)
+				s.Attributes["name"] = fwschema.StringAttribute{
+					Optional: true,
+				}
+				s.Attributes["size"] = fwschema.Float64Attribute{
+					Optional: true,
+				}
+				s.Attributes["subnet_ids"] = fwschema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				}
+				delete(s.Attributes, "arn")
+				s.Attributes["description"] = fwschema.StringAttribute{
+					Optional: true,
+				}
+				s.Blocks["configuration"] = fwschema.SetNestedBlock{
+					NestedObject: fwschema.NestedBlockObject{
+						Attributes: map[string]fwschema.Attribute{
+							"enabled": fwschema.BoolAttribute{
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				}
+				s.Version = 1
+				return s
+			},
+			expected: []string{
+				"schema version: Plugin SDK 0, Plugin Framework 1",
+				"attribute arn: Plugin SDK true, Plugin Framework false",
+				"attribute description: Plugin SDK false, Plugin Framework true",
+				"attribute name required: Plugin SDK true, Plugin Framework false",
+				"attribute name optional: Plugin SDK false, Plugin Framework true",
+				"attribute subnet_ids type: Plugin SDK tftypes.Set[tftypes.String], Plugin Framework tftypes.List[tftypes.String]",
+				"block configuration nesting: Plugin SDK LIST, Plugin Framework SET",
+				"attribute configuration.enabled required: Plugin SDK true, Plugin Framework false",
+				"attribute configuration.enabled optional: Plugin SDK false, Plugin Framework true",
+				"attribute configuration.enabled sensitive: Plugin SDK false, Plugin Framework true",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := ResourceSchemaDiffs(ctx, testCase.sdkv2(), &testResource{schema: testCase.fw(ctx)})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type testResource struct {
+	schema fwschema.Schema
+}
+
+func (r *testResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.schema
+}
+
+func (r *testResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *testResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *testResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
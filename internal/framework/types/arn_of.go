@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate                     = (*arnOfType)(nil)
+	_ basetypes.StringTypable                    = (*arnOfType)(nil)
+	_ basetypes.StringValuable                   = (*ServiceARN)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ServiceARN)(nil)
+)
+
+type arnOfType struct {
+	basetypes.StringType
+	service       string
+	resourceTypes string // "|"-separated resource type prefixes.
+	allowNames    bool
+}
+
+// ARNOf returns a String type whose values must be ARNs of the specified AWS service
+// with a resource beginning with one of the "|"-separated resource types, e.g.
+//
+//	ARNOf("iam", "role")
+//	ARNOf("kms", "key|alias")
+//
+// An empty resourceTypes value matches any resource.
+func ARNOf(service, resourceTypes string) arnOfType {
+	return arnOfType{
+		service:       service,
+		resourceTypes: resourceTypes,
+	}
+}
+
+// ARNOrNameOf is like ARNOf, but values may also be bare resource names.
+// A resource name is semantically equal to an ARN with that resource name, for use where the AWS API accepts either form.
+func ARNOrNameOf(service, resourceTypes string) arnOfType {
+	return arnOfType{
+		service:       service,
+		resourceTypes: resourceTypes,
+		allowNames:    true,
+	}
+}
+
+func (t arnOfType) Equal(o attr.Type) bool {
+	other, ok := o.(arnOfType)
+
+	if !ok {
+		return false
+	}
+
+	return t.service == other.service && t.resourceTypes == other.resourceTypes && t.allowNames == other.allowNames && t.StringType.Equal(other.StringType)
+}
+
+func (t arnOfType) String() string {
+	if t.allowNames {
+		return fmt.Sprintf("ARNOrNameOfType[%s:%s]", t.service, t.resourceTypes)
+	}
+	return fmt.Sprintf("ARNOfType[%s:%s]", t.service, t.resourceTypes)
+}
+
+func (t arnOfType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return t.NullValue(), diags
+	}
+	if in.IsUnknown() {
+		return t.UnknownValue(), diags
+	}
+
+	// Invalid values are reported by Validate.
+	return t.Value(in.ValueString()), diags
+}
+
+func (t arnOfType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t arnOfType) ValueType(context.Context) attr.Value {
+	return ServiceARN{arnType: t}
+}
+
+func (t arnOfType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"ARN Type Validation Error",
+			ProviderErrorDetailPrefix+fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	v, err := arn.Parse(value)
+	if err != nil {
+		if t.allowNames && value != "" {
+			return diags
+		}
+
+		diags.AddAttributeError(
+			path,
+			"ARN Type Validation Error",
+			fmt.Sprintf("Value %q cannot be parsed as an ARN.", value),
+		)
+		return diags
+	}
+
+	if v.Service != t.service {
+		diags.AddAttributeError(
+			path,
+			"ARN Type Validation Error",
+			fmt.Sprintf("Value %q is not an ARN for the %q service, got service %q.", value, t.service, v.Service),
+		)
+		return diags
+	}
+
+	if _, ok := t.resourceType(v.Resource); !ok {
+		diags.AddAttributeError(
+			path,
+			"ARN Type Validation Error",
+			fmt.Sprintf("Value %q is not an ARN of %s resource type %q.", value, t.service, t.resourceTypes),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// resourceType returns the resource type prefix of the specified ARN resource.
+func (t arnOfType) resourceType(resource string) (string, bool) {
+	if t.resourceTypes == "" {
+		return "", true
+	}
+
+	for _, v := range strings.Split(t.resourceTypes, "|") {
+		if strings.HasPrefix(resource, v+"/") || strings.HasPrefix(resource, v+":") {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func (t arnOfType) NullValue() ServiceARN {
+	return ServiceARN{StringValue: basetypes.NewStringNull(), arnType: t}
+}
+
+func (t arnOfType) UnknownValue() ServiceARN {
+	return ServiceARN{StringValue: basetypes.NewStringUnknown(), arnType: t}
+}
+
+// Value returns a known value. The value is not validated.
+func (t arnOfType) Value(value string) ServiceARN {
+	return ServiceARN{StringValue: basetypes.NewStringValue(value), arnType: t}
+}
+
+// ServiceARN is the value of an ARNOf or ARNOrNameOf type.
+type ServiceARN struct {
+	basetypes.StringValue
+	arnType arnOfType
+}
+
+func (v ServiceARN) Equal(o attr.Value) bool {
+	other, ok := o.(ServiceARN)
+
+	if !ok {
+		return false
+	}
+
+	return v.arnType.Equal(other.arnType) && v.StringValue.Equal(other.StringValue)
+}
+
+func (v ServiceARN) Type(context.Context) attr.Type {
+	return v.arnType
+}
+
+// StringSemanticEquals returns whether a resource name and an ARN with that resource name are equal.
+// Other values are equal only if the strings are equal.
+func (v ServiceARN) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ServiceARN)
+	if !ok {
+		return false, diags
+	}
+
+	old, new := v.ValueString(), newValue.ValueString()
+	if old == new {
+		return true, diags
+	}
+
+	if !v.arnType.allowNames {
+		return false, diags
+	}
+
+	switch oldARN, newARN := arn.IsARN(old), arn.IsARN(new); {
+	case oldARN && !newARN:
+		return v.matchesName(new), diags
+	case !oldARN && newARN:
+		return newValue.matchesName(old), diags
+	}
+
+	return false, diags
+}
+
+func (v ServiceARN) matchesName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	return name == v.ResourceName() || name == v.ValueARN().Resource
+}
+
+// IsARN returns whether the known value is an ARN, rather than a resource name.
+func (v ServiceARN) IsARN() bool {
+	return arn.IsARN(v.ValueString())
+}
+
+// ValueARN returns the known arn.ARN value.
+// If the value is null, unknown or not an ARN, returns {}.
+// It's called via reflection inside AutoFlEx.
+func (v ServiceARN) ValueARN() arn.ARN {
+	if v.IsNull() || v.IsUnknown() {
+		return arn.ARN{}
+	}
+
+	// Parse failures return {}.
+	value, _ := arn.Parse(v.ValueString())
+
+	return value
+}
+
+// ResourceType returns the resource type prefix of the known ARN value, e.g. "role".
+// If the value is null, unknown or not an ARN, returns "".
+func (v ServiceARN) ResourceType() string {
+	resourceType, _ := v.arnType.resourceType(v.ValueARN().Resource)
+
+	return resourceType
+}
+
+// ResourceName returns the resource name of the known value.
+// For an ARN this is the final "/"-separated element of the resource following the resource type,
+// e.g. "name" for "arn:aws:iam::123456789012:role/path/name".
+// A value which is not an ARN is returned unchanged.
+func (v ServiceARN) ResourceName() string {
+	if v.IsNull() || v.IsUnknown() {
+		return ""
+	}
+
+	if !v.IsARN() {
+		return v.ValueString()
+	}
+
+	resource := v.ValueARN().Resource
+	if resourceType := v.ResourceType(); resourceType != "" {
+		resource = resource[len(resourceType)+1:]
+	}
+	if i := strings.LastIndex(resource, "/"); i >= 0 {
+		resource = resource[i+1:]
+	}
+
+	return resource
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestARNOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	roleARNType := fwtypes.ARNOf("iam", "role")
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: roleARNType.NullValue(),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: roleARNType.UnknownValue(),
+		},
+		"valid ARN": {
+			val:      tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"), // lintignore:AWSAT005
+			expected: roleARNType.Value("arn:aws:iam::123456789012:role/test"),                // lintignore:AWSAT005
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := roleARNType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestARNOfTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		arnType     xattr.TypeWithValidate
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			arnType:     fwtypes.ARNOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			arnType: fwtypes.ARNOf("iam", "role"),
+			val:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			arnType: fwtypes.ARNOf("iam", "role"),
+			val:     tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid role ARN": {
+			arnType: fwtypes.ARNOf("iam", "role"),
+			val:     tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/path/test"), // lintignore:AWSAT005
+		},
+		"wrong service": {
+			arnType:     fwtypes.ARNOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"wrong resource type": {
+			arnType:     fwtypes.ARNOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:user/test"), // lintignore:AWSAT005
+			expectError: true,
+		},
+		"resource type prefix only": {
+			arnType:     fwtypes.ARNOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:roles/test"), // lintignore:AWSAT005
+			expectError: true,
+		},
+		"one of several resource types": {
+			arnType: fwtypes.ARNOf("kms", "key|alias"),
+			val:     tftypes.NewValue(tftypes.String, "arn:aws:kms:us-east-1:123456789012:alias/test"), // lintignore:AWSAT003,AWSAT005
+		},
+		"any resource type": {
+			arnType: fwtypes.ARNOf("bedrock", ""),
+			val:     tftypes.NewValue(tftypes.String, "arn:aws:bedrock:us-west-2::foundation-model/amazon.titan-text-express-v1"), // lintignore:AWSAT003,AWSAT005
+		},
+		"name": {
+			arnType:     fwtypes.ARNOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, "test"),
+			expectError: true,
+		},
+		"name allowed": {
+			arnType: fwtypes.ARNOrNameOf("iam", "role"),
+			val:     tftypes.NewValue(tftypes.String, "test"),
+		},
+		"empty name with names allowed": {
+			arnType:     fwtypes.ARNOrNameOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, ""),
+			expectError: true,
+		},
+		"name allowed wrong resource type": {
+			arnType:     fwtypes.ARNOrNameOf("iam", "role"),
+			val:         tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:user/test"), // lintignore:AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			diags := test.arnType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestServiceARNStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	roleARNType := fwtypes.ARNOf("iam", "role")
+	roleARNOrNameType := fwtypes.ARNOrNameOf("iam", "role")
+
+	tests := map[string]struct {
+		val1, val2 fwtypes.ServiceARN
+		equals     bool
+	}{
+		"both ARNs equal": {
+			val1:   roleARNType.Value("arn:aws:iam::123456789012:role/test"), // lintignore:AWSAT005
+			val2:   roleARNType.Value("arn:aws:iam::123456789012:role/test"), // lintignore:AWSAT005
+			equals: true,
+		},
+		"both ARNs not equal": {
+			val1: roleARNType.Value("arn:aws:iam::123456789012:role/test1"), // lintignore:AWSAT005
+			val2: roleARNType.Value("arn:aws:iam::123456789012:role/test2"), // lintignore:AWSAT005
+		},
+		"ARN and name not allowed": {
+			val1: roleARNType.Value("arn:aws:iam::123456789012:role/test"), // lintignore:AWSAT005
+			val2: roleARNType.Value("test"),
+		},
+		"ARN and name": {
+			val1:   roleARNOrNameType.Value("arn:aws:iam::123456789012:role/path/test"), // lintignore:AWSAT005
+			val2:   roleARNOrNameType.Value("test"),
+			equals: true,
+		},
+		"name and ARN": {
+			val1:   roleARNOrNameType.Value("test"),
+			val2:   roleARNOrNameType.Value("arn:aws:iam::123456789012:role/path/test"), // lintignore:AWSAT005
+			equals: true,
+		},
+		"ARN and other name": {
+			val1: roleARNOrNameType.Value("arn:aws:iam::123456789012:role/path/test"), // lintignore:AWSAT005
+			val2: roleARNOrNameType.Value("other"),
+		},
+		"both names not equal": {
+			val1: roleARNOrNameType.Value("test1"),
+			val2: roleARNOrNameType.Value("test2"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, expected := equals, test.equals; got != expected {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, expected)
+			}
+		})
+	}
+}
+
+func TestServiceARNParts(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val                  fwtypes.ServiceARN
+		expectedResourceType string
+		expectedResourceName string
+	}{
+		"null": {
+			val: fwtypes.ARNOf("iam", "role").NullValue(),
+		},
+		"role with path": {
+			val:                  fwtypes.ARNOf("iam", "role").Value("arn:aws:iam::123456789012:role/path/test"), // lintignore:AWSAT005
+			expectedResourceType: "role",
+			expectedResourceName: "test",
+		},
+		"alias": {
+			val:                  fwtypes.ARNOf("kms", "key|alias").Value("arn:aws:kms:us-east-1:123456789012:alias/test"), // lintignore:AWSAT003,AWSAT005
+			expectedResourceType: "alias",
+			expectedResourceName: "test",
+		},
+		"colon separator": {
+			val:                  fwtypes.ARNOf("rds", "db").Value("arn:aws:rds:us-east-1:123456789012:db:test"), // lintignore:AWSAT003,AWSAT005
+			expectedResourceType: "db",
+			expectedResourceName: "test",
+		},
+		"name": {
+			val:                  fwtypes.ARNOrNameOf("iam", "role").Value("test"),
+			expectedResourceName: "test",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := test.val.ResourceType(), test.expectedResourceType; got != expected {
+				t.Errorf("ResourceType() = %q, want %q", got, expected)
+			}
+			if got, expected := test.val.ResourceName(), test.expectedResourceName; got != expected {
+				t.Errorf("ResourceName() = %q, want %q", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationValidator validates that a string Attribute's value is a valid, non-negative duration.
+type durationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator durationValidator) Description(_ context.Context) string {
+	return "value must be a valid, non-negative duration, e.g. 10m"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator durationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(request.ConfigValue.ValueString()); err != nil || d < 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// Duration returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid, non-negative Go duration, e.g. `10m`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return durationValidator{}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Allocates one subnet CIDR block per Availability Zone from a VPC CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "CIDR block from which to allocate subnets",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zone names or IDs, in allocation order",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var newbits int64
+	var azs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &newbits, &azs))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrSubnetsForAZs(cidrBlock, newbits, azs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnetsForAZs returns a map of Availability Zone to subnet CIDR block.
+// The subnet for the Availability Zone at index i is the i'th subnet of the CIDR block extended by newbits.
+func cidrSubnetsForAZs(cidrBlock string, newbits int64, azs []string) (map[string]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+
+	_, ipnet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	ones, bits := ipnet.Mask.Size()
+
+	if newbits < 1 {
+		return nil, fmt.Errorf("newbits (%d) must be at least 1", newbits)
+	}
+
+	if prefixLen := int64(ones) + newbits; prefixLen > int64(bits) {
+		return nil, fmt.Errorf("insufficient address space to extend prefix of %d by %d bits", ones, newbits)
+	}
+
+	if max := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(int64(len(azs))).Cmp(max) > 0 {
+		return nil, fmt.Errorf("%d Availability Zones cannot be allocated subnets with %d additional prefix bits (maximum %s)", len(azs), newbits, max)
+	}
+
+	prefixLen := ones + int(newbits)
+	mask := net.CIDRMask(prefixLen, bits)
+	base := new(big.Int).SetBytes(ipnet.IP)
+	result := make(map[string]string, len(azs))
+
+	for i, az := range azs {
+		if _, ok := result[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone (%s)", az)
+		}
+
+		netnum := new(big.Int).Lsh(big.NewInt(int64(i)), uint(bits-prefixLen))
+		ip := new(big.Int).Or(base, netnum).FillBytes(make([]byte, len(ipnet.IP)))
+
+		result[az] = (&net.IPNet{IP: ip, Mask: mask}).String()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock   string
+		newbits     int
+		azs         string
+		expected    string
+		expectError *regexp.Regexp
+	}{
+		"IPv4": {
+			cidrBlock: "10.0.0.0/16",
+			newbits:   8,
+			azs:       `["us-west-2a", "us-west-2b", "us-west-2c"]`,                                         //lintignore:AWSAT003
+			expected:  `{"us-west-2a":"10.0.0.0/24","us-west-2b":"10.0.1.0/24","us-west-2c":"10.0.2.0/24"}`, //lintignore:AWSAT003
+		},
+		"IPv4 Availability Zone IDs": {
+			cidrBlock: "10.1.0.0/20",
+			newbits:   4,
+			azs:       `["usw2-az1", "usw2-az2"]`,
+			expected:  `{"usw2-az1":"10.1.0.0/24","usw2-az2":"10.1.1.0/24"}`,
+		},
+		"IPv6": {
+			cidrBlock: "2600:1f14:abc:de00::/56",
+			newbits:   8,
+			azs:       `["a", "b"]`,
+			expected:  `{"a":"2600:1f14:abc:de00::/64","b":"2600:1f14:abc:de01::/64"}`,
+		},
+		"invalid CIDR block": {
+			cidrBlock:   "10.0.0.1/16",
+			newbits:     8,
+			azs:         `["a"]`,
+			expectError: regexache.MustCompile(`is not a valid CIDR block; did you mean "10.0.0.0/16"`),
+		},
+		"insufficient address space": {
+			cidrBlock:   "10.0.0.0/28",
+			newbits:     8,
+			azs:         `["a"]`,
+			expectError: regexache.MustCompile(`insufficient address space`),
+		},
+		"too many Availability Zones": {
+			cidrBlock:   "10.0.0.0/16",
+			newbits:     1,
+			azs:         `["a", "b", "c"]`,
+			expectError: regexache.MustCompile(`3 Availability Zones cannot be allocated subnets`),
+		},
+		"duplicate Availability Zone": {
+			cidrBlock:   "10.0.0.0/16",
+			newbits:     8,
+			azs:         `["a", "a"]`,
+			expectError: regexache.MustCompile(`duplicate Availability Zone \(a\)`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step := resource.TestStep{
+				Config: testCIDRSubnetsForAZsFunctionConfig(testCase.cidrBlock, testCase.newbits, testCase.azs),
+			}
+			if testCase.expectError != nil {
+				step.ExpectError = testCase.expectError
+			} else {
+				step.Check = resource.TestCheckOutput("test", testCase.expected)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidrBlock string, newbits int, azs string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_subnets_for_azs(%[1]q, %[2]d, %[3]s))
+}
+`, cidrBlock, newbits, azs)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyElementStatement = "Statement"
+	policyElementVersion   = "Version"
+	policyElementID        = "Id"
+	policyElementSid       = "Sid"
+)
+
+// policyStringOrListElements are the statement elements whose value is either a string or a list of strings.
+var policyStringOrListElements = []string{"Action", "NotAction", "Resource", "NotResource"}
+
+// policyPrincipalElements are the statement elements whose value is either "*" or a map of principal type to principals.
+var policyPrincipalElements = []string{"Principal", "NotPrincipal"}
+
+// policyDocument is a generic JSON policy document.
+type policyDocument map[string]any
+
+// decodePolicyDocument decodes a JSON policy document.
+// A single statement object is converted to a list containing that statement.
+func decodePolicyDocument(s string) (policyDocument, error) {
+	var doc policyDocument
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", s, err)
+	}
+
+	if doc == nil {
+		return nil, fmt.Errorf("policy (%s) is not a JSON object", s)
+	}
+
+	switch v := doc[policyElementStatement].(type) {
+	case nil:
+		doc[policyElementStatement] = []any{}
+	case map[string]any:
+		doc[policyElementStatement] = []any{v}
+	case []any:
+		for _, v := range v {
+			if _, ok := v.(map[string]any); !ok {
+				return nil, fmt.Errorf("policy (%s) statement is not a JSON object", s)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("policy (%s) Statement must be a JSON object or array", s)
+	}
+
+	return doc, nil
+}
+
+func (doc policyDocument) statements() []any {
+	v, _ := doc[policyElementStatement].([]any)
+	return v
+}
+
+// normalize normalizes the policy document in place:
+// lists of actions, resources and principals are sorted and single-element lists are replaced by their only element.
+func (doc policyDocument) normalize() {
+	for _, v := range doc.statements() {
+		statement := v.(map[string]any)
+
+		for _, k := range policyStringOrListElements {
+			if v, ok := statement[k]; ok {
+				statement[k] = normalizePolicyStringOrList(v)
+			}
+		}
+
+		for _, k := range policyPrincipalElements {
+			if v, ok := statement[k].(map[string]any); ok {
+				for typ, principals := range v {
+					v[typ] = normalizePolicyStringOrList(principals)
+				}
+			}
+		}
+
+		if v, ok := statement["Condition"].(map[string]any); ok {
+			for _, v := range v {
+				if v, ok := v.(map[string]any); ok {
+					for key, values := range v {
+						// Condition values are not reordered.
+						if values, ok := values.([]any); ok && len(values) == 1 {
+							v[key] = values[0]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// merge merges the statements of another policy document into the policy document.
+// A statement with the same non-empty Sid as an existing statement replaces the existing statement.
+// The latest Version and any Id are adopted, matching the behavior of the aws_iam_policy_document data source.
+func (doc policyDocument) merge(other policyDocument) {
+	if v, ok := other[policyElementID]; ok {
+		doc[policyElementID] = v
+	}
+
+	if v, ok := other[policyElementVersion].(string); ok {
+		if current, _ := doc[policyElementVersion].(string); v > current {
+			doc[policyElementVersion] = v
+		}
+	}
+
+	statements := doc.statements()
+
+	for _, v := range other.statements() {
+		statement := v.(map[string]any)
+
+		if sid, _ := statement[policyElementSid].(string); sid != "" {
+			if i := slices.IndexFunc(statements, func(v any) bool {
+				existing, _ := v.(map[string]any)[policyElementSid].(string)
+				return existing == sid
+			}); i >= 0 {
+				statements[i] = statement
+				continue
+			}
+		}
+
+		statements = append(statements, statement)
+	}
+
+	doc[policyElementStatement] = statements
+}
+
+// string returns the normalized JSON encoding of the policy document with the Version element first.
+// The result is checked to be equivalent to the specified original policy, if any.
+func (doc policyDocument) string(original string) (string, error) {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := verify.LegacyPolicyNormalize(string(b))
+	if err != nil {
+		return "", err
+	}
+
+	if original != "" && !verify.PolicyStringsEquivalent(original, s) {
+		return "", fmt.Errorf("normalized policy (%s) is not equivalent to policy (%s)", s, original)
+	}
+
+	return s, nil
+}
+
+// normalizePolicyStringOrList sorts a list of strings.
+// A single-element list is replaced by its only element.
+func normalizePolicyStringOrList(v any) any {
+	l, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	var ss []string
+	for _, e := range l {
+		s, ok := e.(string)
+		if !ok {
+			// Not a list of strings; leave as is.
+			return v
+		}
+		ss = append(ss, s)
+	}
+
+	slices.Sort(ss)
+
+	if len(ss) == 1 {
+		return ss[0]
+	}
+
+	result := make([]any, len(ss))
+	for i, s := range ss {
+		result[i] = s
+	}
+
+	return result
+}
+
+// normalizePolicy returns the normalized form of a JSON policy document.
+func normalizePolicy(s string) (string, error) {
+	doc, err := decodePolicyDocument(s)
+	if err != nil {
+		return "", err
+	}
+
+	doc.normalize()
+
+	return doc.string(s)
+}
+
+// mergePolicies returns the normalized result of merging the statements of JSON policy documents, in order.
+// Merging no policy documents results in a policy document with no statements.
+func mergePolicies(policies []string) (string, error) {
+	result := policyDocument{
+		policyElementStatement: []any{},
+	}
+
+	for _, s := range policies {
+		doc, err := decodePolicyDocument(s)
+		if err != nil {
+			return "", err
+		}
+
+		result.merge(doc)
+	}
+
+	result.normalize()
+
+	return result.string("")
+}
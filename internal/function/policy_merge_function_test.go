@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyMergeFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args        []string
+		expected    string
+		expectError *regexp.Regexp
+	}{
+		"single policy": {
+			args: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"}]}`,
+		},
+		"distinct Sids": {
+			args: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":{"Sid":"Write","Action":"s3:PutObject","Effect":"Allow","Resource":"*"}}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"Write"}]}`,
+		},
+		"duplicate Sid": {
+			args: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Action":"s3:GetObject","Effect":"Allow","Resource":"*"},{"Sid":"List","Action":"s3:ListBucket","Effect":"Allow","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Action":["s3:GetObjectVersion","s3:GetObject"],"Effect":"Allow","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:GetObjectVersion"],"Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*","Sid":"List"}]}`,
+		},
+		"no Sids": {
+			args: []string{
+				`{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+				`{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"},{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"no policies": {
+			args:     []string{},
+			expected: `{"Statement":[]}`,
+		},
+		"invalid JSON": {
+			args: []string{
+				`{"Version":"2012-10-17","Statement":[]}`,
+				`invalid`,
+			},
+			expectError: regexache.MustCompile(`is invalid JSON`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step := resource.TestStep{
+				Config: testPolicyMergeFunctionConfig(testCase.args),
+			}
+			if testCase.expectError != nil {
+				step.ExpectError = testCase.expectError
+			} else {
+				step.Check = resource.TestCheckOutput("test", testCase.expected)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testPolicyMergeFunctionConfig(args []string) string {
+	quoted := make([]string, len(args))
+	for i, v := range args {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::policy_merge([%[1]s])
+}
+`, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyNormalizeFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arg         string
+		expected    string
+		expectError *regexp.Regexp
+	}{
+		"already normalized": {
+			arg:      `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"whitespace and key order": {
+			arg: `{
+  "Statement": [
+    {
+      "Resource": "*",
+      "Effect": "Allow",
+      "Action": "s3:GetObject"
+    }
+  ],
+  "Version": "2012-10-17"
+}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"single statement object": {
+			arg:      `{"Version":"2012-10-17","Statement":{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		"sorted lists": {
+			arg:      `{"Version":"2012-10-17","Statement":[{"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow","Resource":["*"]}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*"}]}`,
+		},
+		"principals": {
+			arg:      `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"],"AWS":["arn:aws:iam::444455556666:root","arn:aws:iam::111122223333:root"]}}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:root","arn:aws:iam::444455556666:root"],"Service":"lambda.amazonaws.com"}}]}`,
+		},
+		"conditions": {
+			arg:      `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Condition":{"StringEquals":{"aws:PrincipalOrgID":["o-1234567890"]}},"Effect":"Allow","Resource":"*"}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890"}},"Effect":"Allow","Resource":"*"}]}`,
+		},
+		"invalid JSON": {
+			arg:         `{"Version":`,
+			expectError: regexache.MustCompile(`is invalid JSON`),
+		},
+		"invalid statement": {
+			arg:         `{"Version":"2012-10-17","Statement":"invalid"}`,
+			expectError: regexache.MustCompile(`Statement must be a JSON object or array`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step := resource.TestStep{
+				Config: testPolicyNormalizeFunctionConfig(testCase.arg),
+			}
+			if testCase.expectError != nil {
+				step.ExpectError = testCase.expectError
+			} else {
+				step.Check = resource.TestCheckOutput("test", testCase.expected)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testPolicyNormalizeFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::policy_normalize(%[1]q)
+}
+`, arg)
+}
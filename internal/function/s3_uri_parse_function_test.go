@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestS3URIParseFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arg            string
+		expectedBucket string
+		expectedKey    string
+		expectError    *regexp.Regexp
+	}{
+		"bucket only": {
+			arg:            "s3://example-bucket",
+			expectedBucket: "example-bucket",
+			expectedKey:    "",
+		},
+		"bucket with trailing slash": {
+			arg:            "s3://example-bucket/",
+			expectedBucket: "example-bucket",
+			expectedKey:    "",
+		},
+		"object": {
+			arg:            "s3://example-bucket/object.txt",
+			expectedBucket: "example-bucket",
+			expectedKey:    "object.txt",
+		},
+		"object with prefix": {
+			arg:            "s3://example-bucket/path/to/object.txt",
+			expectedBucket: "example-bucket",
+			expectedKey:    "path/to/object.txt",
+		},
+		"prefix": {
+			arg:            "s3://example-bucket/path/to/",
+			expectedBucket: "example-bucket",
+			expectedKey:    "path/to/",
+		},
+		"invalid scheme": {
+			arg:         "https://example-bucket.s3.amazonaws.com/object.txt",
+			expectError: regexache.MustCompile(`must begin with "s3://"`),
+		},
+		"no bucket": {
+			arg:         "s3:///object.txt",
+			expectError: regexache.MustCompile(`has no bucket name`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step := resource.TestStep{
+				Config: testS3URIParseFunctionConfig(testCase.arg),
+			}
+			if testCase.expectError != nil {
+				step.ExpectError = testCase.expectError
+			} else {
+				step.Check = resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("bucket", testCase.expectedBucket),
+					resource.TestCheckOutput("key", testCase.expectedKey),
+				)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testS3URIParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::s3_uri_parse(%[1]q)
+}
+
+output "bucket" {
+  value = local.result.bucket
+}
+
+output "key" {
+  value = local.result.key
+}
+`, arg)
+}
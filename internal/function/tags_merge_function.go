@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsMergeFunction{}
+
+func NewTagsMergeFunction() function.Function {
+	return &tagsMergeFunction{}
+}
+
+type tagsMergeFunction struct{}
+
+func (f tagsMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_merge"
+}
+
+func (f tagsMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "tags_merge Function",
+		MarkdownDescription: "Merges default tags with resource tags using the same precedence as the provider's `default_tags` configuration block: a resource tag overrides a default tag with the same key",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "default_tags",
+				MarkdownDescription: "Default tags, as configured in the provider's `default_tags` configuration block",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Resource tags",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var defaultTags, tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &defaultTags, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tagsMerge(ctx, defaultTags, tags)))
+}
+
+// tagsMerge returns the result of merging resource tags with default tags.
+func tagsMerge(ctx context.Context, defaultTags, tags map[string]string) map[string]string {
+	defaultTagsConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, defaultTags),
+	}
+
+	result := defaultTagsConfig.MergeTags(tftags.New(ctx, tags), "").Map()
+	if result == nil {
+		result = make(map[string]string)
+	}
+
+	return result
+}
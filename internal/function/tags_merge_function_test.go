@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsMergeFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		defaultTags string
+		tags        string
+		expected    string
+	}{
+		"no tags": {
+			defaultTags: `{}`,
+			tags:        `{}`,
+			expected:    `{}`,
+		},
+		"null default tags": {
+			defaultTags: `null`,
+			tags:        `{ key1 = "value1" }`,
+			expected:    `{"key1":"value1"}`,
+		},
+		"null tags": {
+			defaultTags: `{ key1 = "value1" }`,
+			tags:        `null`,
+			expected:    `{"key1":"value1"}`,
+		},
+		"distinct keys": {
+			defaultTags: `{ key1 = "value1" }`,
+			tags:        `{ key2 = "value2" }`,
+			expected:    `{"key1":"value1","key2":"value2"}`,
+		},
+		"overlapping keys": {
+			defaultTags: `{ key1 = "default1", key2 = "default2" }`,
+			tags:        `{ key1 = "value1" }`,
+			expected:    `{"key1":"value1","key2":"default2"}`,
+		},
+		"empty value overrides default": {
+			defaultTags: `{ key1 = "default1" }`,
+			tags:        `{ key1 = "" }`,
+			expected:    `{"key1":""}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: testTagsMergeFunctionConfig(testCase.defaultTags, testCase.tags),
+						Check:  resource.TestCheckOutput("test", testCase.expected),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testTagsMergeFunctionConfig(defaultTags, tags string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::tags_merge(%[1]s, %[2]s))
+}
+`, defaultTags, tags)
+}
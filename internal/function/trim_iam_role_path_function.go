@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	iamService       = "iam"
+	iamRoleResPrefix = "role/"
+)
+
+var _ function.Function = trimIAMRolePathFunction{}
+
+func NewTrimIAMRolePathFunction() function.Function {
+	return &trimIAMRolePathFunction{}
+}
+
+type trimIAMRolePathFunction struct{}
+
+func (f trimIAMRolePathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "trim_iam_role_path"
+}
+
+func (f trimIAMRolePathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "trim_iam_role_path Function",
+		MarkdownDescription: "Trims the path prefix from an IAM role Amazon Resource Name (ARN). This function can be used when services require role ARNs to be passed without a path.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "IAM role Amazon Resource Name (ARN)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f trimIAMRolePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := trimIAMRolePath(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// trimIAMRolePath removes the path from an IAM role ARN.
+func trimIAMRolePath(s string) (string, error) {
+	parts, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	if parts.Service != iamService {
+		return "", fmt.Errorf(`IAM role ARN must begin with "arn:<partition>:%s:"`, iamService)
+	}
+
+	if !strings.HasPrefix(parts.Resource, iamRoleResPrefix) {
+		return "", fmt.Errorf("IAM role ARN resource must begin with %q", iamRoleResPrefix)
+	}
+
+	name := parts.Resource[strings.LastIndex(parts.Resource, "/")+1:]
+	if name == "" {
+		return "", fmt.Errorf("IAM role ARN (%s) has no role name", s)
+	}
+
+	parts.Resource = iamRoleResPrefix + name
+
+	return parts.String(), nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTrimIAMRolePathFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arg         string
+		expected    string
+		expectError *regexp.Regexp
+	}{
+		"no path": {
+			arg:      "arn:aws:iam::444455556666:role/example",
+			expected: "arn:aws:iam::444455556666:role/example",
+		},
+		"single path segment": {
+			arg:      "arn:aws:iam::444455556666:role/path/example",
+			expected: "arn:aws:iam::444455556666:role/example",
+		},
+		"multiple path segments": {
+			arg:      "arn:aws:iam::444455556666:role/path/with/multiple/segments/example",
+			expected: "arn:aws:iam::444455556666:role/example",
+		},
+		"other partition": {
+			arg:      "arn:aws-us-gov:iam::444455556666:role/path/example",
+			expected: "arn:aws-us-gov:iam::444455556666:role/example",
+		},
+		"invalid ARN": {
+			arg:         "invalid",
+			expectError: regexache.MustCompile("arn: invalid prefix"),
+		},
+		"invalid service": {
+			arg:         "arn:aws:s3:::bucket/role/example",
+			expectError: regexache.MustCompile(`IAM role ARN must begin with`),
+		},
+		"invalid resource": {
+			arg:         "arn:aws:iam::444455556666:user/path/example",
+			expectError: regexache.MustCompile(`IAM role ARN resource must begin with "role/"`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			step := resource.TestStep{
+				Config: testTrimIAMRolePathFunctionConfig(testCase.arg),
+			}
+			if testCase.expectError != nil {
+				step.ExpectError = testCase.expectError
+			} else {
+				step.Check = resource.TestCheckOutput("test", testCase.expected)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+				},
+				Steps: []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testTrimIAMRolePathFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::trim_iam_role_path(%[1]q)
+}
+`, arg)
+}
@@ -103,8 +103,14 @@ func main() {
 }
 
 type ResourceDatum struct {
+	Cacheable               bool // Data source reads may be served from the provider's data source cache
 	FactoryName             string
-	Name                    string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
+	IAMActions              bool
+	IAMCreateActions        []string
+	IAMUpdateActions        []string
+	IAMDeleteActions        []string
+	IAMPolicyAttributes     []string // Top-level attributes whose values are IAM policy documents
+	Name                    string   // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
@@ -191,6 +197,32 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Cacheable" {
+			d.Cacheable = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IAMActions" {
+			args := common.ParseArgs(m[3])
+
+			d.IAMActions = true
+
+			for k, v := range map[string]*[]string{
+				"create": &d.IAMCreateActions,
+				"update": &d.IAMUpdateActions,
+				"delete": &d.IAMDeleteActions,
+			} {
+				if attr, ok := args.Keyword[k]; ok {
+					*v = append(*v, strings.Split(attr, ";")...)
+				}
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IAMPolicyAttributes" {
+			args := common.ParseArgs(m[3])
+
+			d.IAMPolicyAttributes = append(d.IAMPolicyAttributes, args.Positional...)
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -224,18 +256,43 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 			switch annotationName := m[1]; annotationName {
 			case "FrameworkDataSource":
+				if len(d.IAMPolicyAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("IAMPolicyAttributes annotation on Framework data source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				if d.IAMActions {
+					v.errs = append(v.errs, fmt.Errorf("IAMActions annotation on data source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
 			case "FrameworkResource":
+				// Framework resources' IAM policy attributes are those of type fwtypes.IAMPolicyType.
+				if len(d.IAMPolicyAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("IAMPolicyAttributes annotation on Framework resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				if d.Cacheable {
+					v.errs = append(v.errs, fmt.Errorf("Cacheable annotation on resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
 			case "SDKDataSource":
+				if len(d.IAMPolicyAttributes) > 0 {
+					v.errs = append(v.errs, fmt.Errorf("IAMPolicyAttributes annotation on data source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				if d.IAMActions {
+					v.errs = append(v.errs, fmt.Errorf("IAMActions annotation on data source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -249,6 +306,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkDataSources[typeName] = d
 				}
 			case "SDKResource":
+				if d.Cacheable {
+					v.errs = append(v.errs, fmt.Errorf("Cacheable annotation on resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 					continue
@@ -261,7 +322,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Cacheable", "IAMActions", "IAMPolicyAttributes", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
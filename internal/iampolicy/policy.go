@@ -0,0 +1,534 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy compares AWS IAM policy documents.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+// DiffSummary is the summary of the plan warning describing changes to an IAM policy attribute.
+const DiffSummary = "IAM policy changes"
+
+// DiffDetail returns the detail of the plan warning describing changes to an IAM policy attribute.
+func DiffDetail(attribute, diff string) string {
+	return fmt.Sprintf("The %q IAM policy will be changed as follows:\n\n%s", attribute, diff)
+}
+
+// document is a normalized IAM policy document.
+type document struct {
+	Version    string
+	ID         string
+	Statements []*statement
+}
+
+// statement is a normalized IAM policy statement.
+// Actions are lowercased, condition keys are lowercased and all multi-valued elements are sorted, with duplicates removed.
+type statement struct {
+	Sid          string                         `json:",omitempty"`
+	Effect       string                         `json:",omitempty"`
+	Action       []string                       `json:",omitempty"`
+	NotAction    []string                       `json:",omitempty"`
+	Resource     []string                       `json:",omitempty"`
+	NotResource  []string                       `json:",omitempty"`
+	Principal    map[string][]string            `json:",omitempty"`
+	NotPrincipal map[string][]string            `json:",omitempty"`
+	Condition    map[string]map[string][]string `json:",omitempty"`
+
+	// index is the statement's 1-based position in the original document.
+	index int
+	// spellings maps normalized action names to their spelling in the original document.
+	spellings map[string]string
+}
+
+// key returns a canonical representation of the statement.
+func (s *statement) key() string {
+	b, _ := json.Marshal(s) // Marshaling strings, slices of strings and maps of them can't fail.
+
+	return string(b)
+}
+
+// display returns the statement as JSON, with actions spelled as in the original document.
+func (s *statement) display() string {
+	v := *s
+	v.Action = s.spellActions(s.Action)
+	v.NotAction = s.spellActions(s.NotAction)
+
+	return v.key()
+}
+
+// spellActions returns the original spellings of the specified normalized action names.
+func (s *statement) spellActions(actions []string) []string {
+	if actions == nil {
+		return nil
+	}
+
+	spelled := make([]string, len(actions))
+	for i, v := range actions {
+		spelled[i] = s.spellAction(v)
+	}
+
+	return spelled
+}
+
+// spellAction returns the original spelling of the specified normalized action name.
+func (s *statement) spellAction(action string) string {
+	if v, ok := s.spellings[action]; ok {
+		return v
+	}
+
+	return action
+}
+
+// label returns a human-readable reference to the statement.
+func (s *statement) label() string {
+	if s.Sid != "" {
+		return fmt.Sprintf("Statement %q", s.Sid)
+	}
+
+	return fmt.Sprintf("Statement #%d", s.index)
+}
+
+// parse parses and normalizes a JSON IAM policy document.
+func parse(s string) (*document, error) {
+	var raw map[string]any
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	doc := &document{}
+
+	for k, v := range raw {
+		switch k {
+		case "Version":
+			doc.Version = stringOf(v)
+		case "Id":
+			doc.ID = stringOf(v)
+		case "Statement":
+			var rawStatements []any
+			switch v := v.(type) {
+			case []any:
+				rawStatements = v
+			case map[string]any:
+				rawStatements = []any{v}
+			default:
+				return nil, errors.New("parsing policy: Statement must be an object or an array of objects")
+			}
+
+			for i, v := range rawStatements {
+				m, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("parsing policy: Statement #%d is not an object", i+1)
+				}
+
+				statement, err := parseStatement(m)
+				if err != nil {
+					return nil, fmt.Errorf("parsing policy: Statement #%d: %w", i+1, err)
+				}
+				statement.index = i + 1
+
+				doc.Statements = append(doc.Statements, statement)
+			}
+		default:
+			return nil, fmt.Errorf("parsing policy: unsupported element %q", k)
+		}
+	}
+
+	return doc, nil
+}
+
+func parseStatement(raw map[string]any) (*statement, error) {
+	s := &statement{}
+
+	for k, v := range raw {
+		var err error
+
+		switch k {
+		case "Sid":
+			s.Sid = stringOf(v)
+		case "Effect":
+			s.Effect = stringOf(v)
+		case "Action":
+			s.Action, err = s.actions(v)
+		case "NotAction":
+			s.NotAction, err = s.actions(v)
+		case "Resource":
+			s.Resource, err = stringSet(v)
+		case "NotResource":
+			s.NotResource, err = stringSet(v)
+		case "Principal":
+			s.Principal, err = principals(v)
+		case "NotPrincipal":
+			s.NotPrincipal, err = principals(v)
+		case "Condition":
+			s.Condition, err = conditions(v)
+		default:
+			err = fmt.Errorf("unsupported element %q", k)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return s, nil
+}
+
+// stringOf returns the string form of a scalar JSON value.
+func stringOf(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// stringSet returns the sorted, unique strings in a JSON string or array of strings.
+// A single string is equivalent to an array containing only that string.
+func stringSet(v any) ([]string, error) {
+	var s []string
+
+	switch v := v.(type) {
+	case []any:
+		for _, v := range v {
+			switch v.(type) {
+			case []any, map[string]any:
+				return nil, errors.New("must be a string or an array of strings")
+			}
+			s = append(s, stringOf(v))
+		}
+	case map[string]any:
+		return nil, errors.New("must be a string or an array of strings")
+	default:
+		s = []string{stringOf(v)}
+	}
+
+	slices.Sort(s)
+
+	return slices.Compact(s), nil
+}
+
+// actions returns the normalized actions in a JSON string or array of strings,
+// recording their original spellings in the statement.
+// Action names are not case sensitive.
+func (s *statement) actions(v any) ([]string, error) {
+	actions, err := stringSet(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.spellings == nil {
+		s.spellings = make(map[string]string)
+	}
+
+	for i, v := range actions {
+		lower := strings.ToLower(v)
+		if _, ok := s.spellings[lower]; !ok {
+			s.spellings[lower] = v
+		}
+		actions[i] = lower
+	}
+
+	slices.Sort(actions)
+
+	return slices.Compact(actions), nil
+}
+
+var accountRootPrincipalRegexp = regexache.MustCompile(`^arn:aws[a-z-]*:iam::(\d{12}):root$`)
+
+// principals returns the normalized principals in a JSON principal element.
+// "*" is equivalent to {"AWS": "*"} and an account's root user ARN is equivalent to the account ID.
+func principals(v any) (map[string][]string, error) {
+	m := make(map[string][]string)
+
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, errors.New(`must be "*" or an object`)
+		}
+		m["AWS"] = []string{"*"}
+	case map[string]any:
+		for k, v := range v {
+			s, err := stringSet(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+
+			if k == "AWS" {
+				for i, v := range s {
+					if match := accountRootPrincipalRegexp.FindStringSubmatch(v); match != nil {
+						s[i] = match[1]
+					}
+				}
+				slices.Sort(s)
+				s = slices.Compact(s)
+			}
+
+			m[k] = s
+		}
+	default:
+		return nil, errors.New(`must be "*" or an object`)
+	}
+
+	return m, nil
+}
+
+// conditions returns the normalized conditions in a JSON condition element.
+// Condition keys are not case sensitive.
+func conditions(v any) (map[string]map[string][]string, error) {
+	raw, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("must be an object")
+	}
+
+	m := make(map[string]map[string][]string)
+
+	for operator, v := range raw {
+		raw, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: must be an object", operator)
+		}
+
+		keys := make(map[string][]string)
+
+		for k, v := range raw {
+			s, err := stringSet(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %w", operator, k, err)
+			}
+
+			if lower := strings.ToLower(k); keys[lower] == nil {
+				k = lower
+			}
+
+			keys[k] = s
+		}
+
+		m[operator] = keys
+	}
+
+	return m, nil
+}
+
+// Equivalent returns whether two JSON IAM policy documents are semantically equivalent.
+// Empty strings and empty JSON objects are equivalent.
+func Equivalent(s1, s2 string) bool {
+	if isEmpty(s1) && isEmpty(s2) {
+		return true
+	}
+
+	if doc1, err := parse(s1); err == nil {
+		if doc2, err := parse(s2); err == nil && doc1.equal(doc2) {
+			return true
+		}
+	}
+
+	// Fall back to the awspolicyequivalence module's rules, which also accept documents that aren't parsed here.
+	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+func isEmpty(s string) bool {
+	s = strings.TrimSpace(s)
+
+	return s == "" || s == "{}"
+}
+
+func (d *document) equal(other *document) bool {
+	if d.Version != other.Version || d.ID != other.ID {
+		return false
+	}
+
+	removed, added := d.unmatchedStatements(other)
+
+	return len(removed) == 0 && len(added) == 0
+}
+
+// unmatchedStatements returns the statements in d that are not in other, and those in other that are not in d.
+// Statement order is not significant.
+func (d *document) unmatchedStatements(other *document) ([]*statement, []*statement) {
+	added := slices.Clone(other.Statements)
+	var removed []*statement
+
+	for _, s := range d.Statements {
+		key := s.key()
+		if i := slices.IndexFunc(added, func(v *statement) bool { return v.key() == key }); i >= 0 {
+			added = slices.Delete(added, i, i+1)
+		} else {
+			removed = append(removed, s)
+		}
+	}
+
+	return removed, added
+}
+
+// Diff returns a human-readable, statement-level description of the differences between two JSON IAM policy documents.
+// An empty string is returned if the documents are equivalent.
+func Diff(old, new string) (string, error) {
+	if isEmpty(old) {
+		old = "{}"
+	}
+	if isEmpty(new) {
+		new = "{}"
+	}
+
+	oldDoc, err := parse(old)
+	if err != nil {
+		return "", err
+	}
+
+	newDoc, err := parse(new)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+
+	if oldDoc.Version != newDoc.Version {
+		fmt.Fprintf(&buf, "~ Version: %q => %q\n", oldDoc.Version, newDoc.Version)
+	}
+	if oldDoc.ID != newDoc.ID {
+		fmt.Fprintf(&buf, "~ Id: %q => %q\n", oldDoc.ID, newDoc.ID)
+	}
+
+	removed, added := oldDoc.unmatchedStatements(newDoc)
+
+	// Pair changed statements by Sid, then any remaining statements without a Sid in document order.
+	type pair struct {
+		old, new *statement
+	}
+	var pairs []pair
+
+	for _, s := range slices.Clone(removed) {
+		if s.Sid == "" {
+			continue
+		}
+		if i := slices.IndexFunc(added, func(v *statement) bool { return v.Sid == s.Sid }); i >= 0 {
+			pairs = append(pairs, pair{old: s, new: added[i]})
+			added = slices.Delete(added, i, i+1)
+			removed = slices.DeleteFunc(removed, func(v *statement) bool { return v == s })
+		}
+	}
+
+	for len(removed) > 0 && len(added) > 0 {
+		i := slices.IndexFunc(removed, func(v *statement) bool { return v.Sid == "" })
+		j := slices.IndexFunc(added, func(v *statement) bool { return v.Sid == "" })
+		if i < 0 || j < 0 {
+			break
+		}
+
+		pairs = append(pairs, pair{old: removed[i], new: added[j]})
+		removed = slices.Delete(removed, i, i+1)
+		added = slices.Delete(added, j, j+1)
+	}
+
+	for _, v := range pairs {
+		fmt.Fprintf(&buf, "~ %s\n", v.new.label())
+		writeStatementDiff(&buf, v.old, v.new)
+	}
+	for _, v := range removed {
+		fmt.Fprintf(&buf, "- %s: %s\n", v.label(), v.display())
+	}
+	for _, v := range added {
+		fmt.Fprintf(&buf, "+ %s: %s\n", v.label(), v.display())
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func writeStatementDiff(buf *bytes.Buffer, old, new *statement) {
+	const indent = "    "
+
+	if old.Sid != new.Sid {
+		fmt.Fprintf(buf, "%sSid: %q => %q\n", indent, old.Sid, new.Sid)
+	}
+	if old.Effect != new.Effect {
+		fmt.Fprintf(buf, "%sEffect: %q => %q\n", indent, old.Effect, new.Effect)
+	}
+
+	writeActionDiff(buf, indent+"Action", old, new, old.Action, new.Action)
+	writeActionDiff(buf, indent+"NotAction", old, new, old.NotAction, new.NotAction)
+	writeStringSetDiff(buf, indent+"Resource", old.Resource, new.Resource)
+	writeStringSetDiff(buf, indent+"NotResource", old.NotResource, new.NotResource)
+
+	for _, k := range sortedKeys(old.Principal, new.Principal) {
+		writeStringSetDiff(buf, fmt.Sprintf("%sPrincipal %s", indent, k), old.Principal[k], new.Principal[k])
+	}
+	for _, k := range sortedKeys(old.NotPrincipal, new.NotPrincipal) {
+		writeStringSetDiff(buf, fmt.Sprintf("%sNotPrincipal %s", indent, k), old.NotPrincipal[k], new.NotPrincipal[k])
+	}
+
+	for _, operator := range sortedKeys(old.Condition, new.Condition) {
+		for _, k := range sortedKeys(old.Condition[operator], new.Condition[operator]) {
+			writeStringSetDiff(buf, fmt.Sprintf("%sCondition %s %q", indent, operator, k), old.Condition[operator][k], new.Condition[operator][k])
+		}
+	}
+}
+
+// writeStringSetDiff writes the values added to and removed from a normalized set of strings.
+func writeStringSetDiff(buf *bytes.Buffer, name string, old, new []string) {
+	identity := func(v string) string { return v }
+
+	writeStringSetDiffFunc(buf, name, old, new, identity, identity)
+}
+
+// writeActionDiff writes the actions added to and removed from a statement's normalized actions.
+// Actions are compared normalized but written as spelled in the statement they are from.
+func writeActionDiff(buf *bytes.Buffer, name string, oldStatement, newStatement *statement, old, new []string) {
+	writeStringSetDiffFunc(buf, name, old, new, oldStatement.spellAction, newStatement.spellAction)
+}
+
+// writeStringSetDiffFunc writes the values added to and removed from a normalized set of strings,
+// using the specified functions to display the old and new values.
+func writeStringSetDiffFunc(buf *bytes.Buffer, name string, old, new []string, oldDisplay, newDisplay func(string) string) {
+	var changes []string
+
+	for _, v := range new {
+		if _, found := slices.BinarySearch(old, v); !found {
+			changes = append(changes, fmt.Sprintf("+ %q", newDisplay(v)))
+		}
+	}
+	for _, v := range old {
+		if _, found := slices.BinarySearch(new, v); !found {
+			changes = append(changes, fmt.Sprintf("- %q", oldDisplay(v)))
+		}
+	}
+
+	if len(changes) > 0 {
+		fmt.Fprintf(buf, "%s: %s\n", name, strings.Join(changes, ", "))
+	}
+}
+
+func sortedKeys[V any](m1, m2 map[string]V) []string {
+	var keys []string
+
+	for k := range m1 {
+		keys = append(keys, k)
+	}
+	for k := range m2 {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return slices.Compact(keys)
+}
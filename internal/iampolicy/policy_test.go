@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+)
+
+func TestEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy1, policy2 string
+		want             bool
+	}{
+		"empty": {
+			policy1: "",
+			policy2: "{}",
+			want:    true,
+		},
+		"single value arrays": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}}`,
+			want:    true,
+		},
+		"wildcard principal": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:    true,
+		},
+		"account root principal": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"sts:AssumeRole"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"sts:AssumeRole"}]}`,
+			want:    true,
+		},
+		"condition key case": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:sourceaccount":["123456789012"]}}}]}`,
+			want:    true,
+		},
+		"condition value changed": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789013"}}}]}`,
+		},
+		"statement order": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want:    true,
+		},
+		"NotAction forms": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["iam:*","S3:GetObject"],"Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":["s3:getobject","iam:*","iam:*"],"Resource":"*"}]}`,
+			want:    true,
+		},
+		"NotAction is not Action": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"iam:*","Resource":"*"}]}`,
+		},
+		"different versions": {
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"invalid JSON": {
+			policy1: `{"Version":"2012-10-17"`,
+			policy2: `{"Version":"2012-10-17"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := Equivalent(testCase.policy1, testCase.policy2), testCase.want; got != want {
+				t.Errorf("Equivalent = %t, want %t", got, want)
+			}
+			if got, want := Equivalent(testCase.policy2, testCase.policy1), testCase.want; got != want {
+				t.Errorf("Equivalent (reversed) = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old, new string
+		want     string
+		wantErr  bool
+	}{
+		"equivalent": {
+			old:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}]}`,
+			new:  `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			want: "",
+		},
+		"condition change": {
+			old: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			new: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":"123456789013"}}}]}`,
+			want: `~ Statement "Read"
+    Condition StringEquals "aws:sourceaccount": + "123456789013", - "123456789012"`,
+		},
+		"statement without Sid changed": {
+			old: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"},{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			new: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			want: `~ Statement #1
+    Action: + "s3:ListBucket"`,
+		},
+		"statements added and removed": {
+			old: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			new: `{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			want: `- Statement "A": {"Sid":"A","Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}
++ Statement "B": {"Sid":"B","Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}`,
+		},
+		"action spelling preserved": {
+			old: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["S3:GetObject","iam:PassRole"],"NotAction":"EC2:*","Resource":"*"}]}`,
+			new: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:getobject","S3:ListBucket"],"NotAction":"ec2:*","Resource":"*"}]}`,
+			want: `~ Statement "Read"
+    Action: + "S3:ListBucket", - "iam:PassRole"`,
+		},
+		"version and principal": {
+			old: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole"}]}`,
+			new: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			want: `~ Version: "2008-10-17" => "2012-10-17"
+~ Statement #1
+    Principal AWS: - "*"
+    Principal Service: + "ec2.amazonaws.com"`,
+		},
+		"invalid": {
+			old:     `{"Version":"2012-10-17","Statement":"x"}`,
+			new:     `{}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(testCase.old, testCase.new)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Diff err = %v, want error %t", err, want)
+			}
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("Diff =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
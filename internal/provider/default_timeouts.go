@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// defaultTimeoutsProviderServer wraps the Plugin SDK provider server and applies the provider's default timeouts
+// to resources of matching types when they are created or updated.
+// The Plugin SDK records a resource's timeouts, resolved from its own defaults and its `timeouts` configuration block,
+// in the planned private state and decodes them from there before calling the resource's CRUD handlers.
+// The provider default timeouts of the configured client replace those that aren't configured in the resource's `timeouts` block.
+// The resulting timeouts are saved in the resource's private state and used when it is later read or deleted.
+type defaultTimeoutsProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func newDefaultTimeoutsProviderServer(_ context.Context, provider *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &defaultTimeoutsProviderServer{
+			ProviderServer: server(),
+			provider:       provider,
+		}
+	}
+}
+
+func (s *defaultTimeoutsProviderServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if v, ok := s.defaultTimeouts(request); ok {
+		private, err := privateWithDefaultTimeouts(request.PlannedPrivate, v)
+
+		if err != nil {
+			tflog.Warn(ctx, "applying provider default timeouts", map[string]any{
+				"error": err.Error(),
+			})
+		} else {
+			request.PlannedPrivate = private
+		}
+	}
+
+	return s.ProviderServer.ApplyResourceChange(ctx, request)
+}
+
+// defaultTimeouts returns the provider default timeouts for a resource being created or updated,
+// excluding those configured in the resource's `timeouts` block.
+func (s *defaultTimeoutsProviderServer) defaultTimeouts(request *tfprotov5.ApplyResourceChangeRequest) (conns.DefaultTimeouts, bool) {
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok {
+		return conns.DefaultTimeouts{}, false
+	}
+
+	v, ok := meta.DefaultTimeoutsConfig.For(request.TypeName)
+	if !ok {
+		return conns.DefaultTimeouts{}, false
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok || r.Timeouts == nil {
+		return conns.DefaultTimeouts{}, false
+	}
+
+	timeouts, ok := attributeFromDynamicValue(request.Config, r.CoreConfigSchema().ImpliedType(), schema.TimeoutsConfigKey)
+	// Destroy.
+	if !ok {
+		return conns.DefaultTimeouts{}, false
+	}
+
+	if !timeouts.IsNull() && timeouts.IsKnown() {
+		configured := func(key string) bool {
+			return timeouts.Type().HasAttribute(key) && !timeouts.GetAttr(key).IsNull()
+		}
+
+		if configured(schema.TimeoutCreate) {
+			v.Create = 0
+		}
+		if configured(schema.TimeoutRead) {
+			v.Read = 0
+		}
+		if configured(schema.TimeoutUpdate) {
+			v.Update = 0
+		}
+		if configured(schema.TimeoutDelete) {
+			v.Delete = 0
+		}
+	}
+
+	return v, true
+}
+
+// privateWithDefaultTimeouts returns a copy of the specified Plugin SDK private state with any non-zero default timeouts applied.
+// Only timeouts that are already recorded, i.e. that the resource supports, are replaced.
+func privateWithDefaultTimeouts(private []byte, defaults conns.DefaultTimeouts) ([]byte, error) {
+	if len(private) == 0 {
+		return private, nil
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(private, &m); err != nil {
+		return nil, err
+	}
+
+	timeouts, ok := m[schema.TimeoutKey].(map[string]any)
+	if !ok {
+		return private, nil
+	}
+
+	for key, v := range map[string]int64{
+		schema.TimeoutCreate: defaults.Create.Nanoseconds(),
+		schema.TimeoutRead:   defaults.Read.Nanoseconds(),
+		schema.TimeoutUpdate: defaults.Update.Nanoseconds(),
+		schema.TimeoutDelete: defaults.Delete.Nanoseconds(),
+	} {
+		if _, ok := timeouts[key]; ok && v > 0 {
+			timeouts[key] = v
+		}
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestDefaultTimeoutsProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		config   conns.DefaultTimeoutsConfig
+		timeouts map[string]cty.Value
+		want     time.Duration
+	}{
+		"no default timeouts": {
+			want: 10 * time.Minute,
+		},
+		"matching default timeouts": {
+			config: conns.DefaultTimeoutsConfig{
+				{ResourceType: "aws_test", Create: 30 * time.Minute},
+			},
+			want: 30 * time.Minute,
+		},
+		"non-matching default timeouts": {
+			config: conns.DefaultTimeoutsConfig{
+				{ResourceType: "aws_other_*", Create: 30 * time.Minute},
+			},
+			want: 10 * time.Minute,
+		},
+		"other operation default timeouts": {
+			config: conns.DefaultTimeoutsConfig{
+				{ResourceType: "aws_*", Delete: 30 * time.Minute},
+			},
+			want: 10 * time.Minute,
+		},
+		"configured timeout": {
+			config: conns.DefaultTimeoutsConfig{
+				{ResourceType: "aws_test", Create: 30 * time.Minute},
+			},
+			timeouts: map[string]cty.Value{
+				schema.TimeoutCreate: cty.StringVal("5m"),
+				schema.TimeoutDelete: cty.NullVal(cty.String),
+			},
+			want: 5 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got time.Duration
+			noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				return nil
+			}
+			createTimeout, deleteTimeout := 10*time.Minute, 20*time.Minute
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				Timeouts: &schema.ResourceTimeout{
+					Create: &createTimeout,
+					Delete: &deleteTimeout,
+				},
+				CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+					got = d.Timeout(schema.TimeoutCreate)
+					d.SetId("test")
+
+					return nil
+				},
+				ReadWithoutTimeout:   noop,
+				DeleteWithoutTimeout: noop,
+			}
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					"aws_test": r,
+				},
+			}
+			provider.SetMeta(&conns.AWSClient{DefaultTimeoutsConfig: testCase.config})
+			server := newDefaultTimeoutsProviderServer(ctx, provider, provider.GRPCProvider)()
+
+			typ := r.CoreConfigSchema().ImpliedType()
+			timeouts := cty.NullVal(typ.AttributeType(schema.TimeoutsConfigKey))
+			if testCase.timeouts != nil {
+				timeouts = cty.ObjectVal(testCase.timeouts)
+			}
+			config := dynamicValue(t, cty.ObjectVal(map[string]cty.Value{
+				"id":                     cty.NullVal(cty.String),
+				"name":                   cty.StringVal("test"),
+				schema.TimeoutsConfigKey: timeouts,
+			}), typ)
+			prior := dynamicValue(t, cty.NullVal(typ), typ)
+
+			plan, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				PriorState:       prior,
+				ProposedNewState: config,
+				Config:           config,
+			})
+			if err != nil {
+				t.Fatalf("planning: %s", err)
+			}
+			if len(plan.Diagnostics) > 0 {
+				t.Fatalf("planning: %v", plan.Diagnostics)
+			}
+
+			apply, err := server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+				TypeName:       "aws_test",
+				PriorState:     prior,
+				PlannedState:   plan.PlannedState,
+				Config:         config,
+				PlannedPrivate: plan.PlannedPrivate,
+			})
+			if err != nil {
+				t.Fatalf("applying: %s", err)
+			}
+			if len(apply.Diagnostics) > 0 {
+				t.Fatalf("applying: %v", apply.Diagnostics)
+			}
+
+			if got != testCase.want {
+				t.Errorf("Create timeout = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPrivateWithDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	private := []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":2400000000000,"delete":3600000000000,"update":4800000000000},"schema_version":"1"}`)
+	defaults := conns.DefaultTimeouts{
+		Create: 90 * time.Minute,
+		Read:   10 * time.Minute,
+		Delete: 120 * time.Minute,
+	}
+
+	got, err := privateWithDefaultTimeouts(private, defaults)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Read isn't supported by the resource, so isn't added.
+	want := `{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":5400000000000,"delete":7200000000000,"update":4800000000000},"schema_version":"1"}`
+
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func dynamicValue(t *testing.T, v cty.Value, typ cty.Type) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	b, err := ctymsgpack.Marshal(v, typ)
+	if err != nil {
+		t.Fatalf("marshaling: %s", err)
+	}
+
+	return &tfprotov5.DynamicValue{MsgPack: b}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionGuardInterceptor prevents the deletion of resources that match the provider's deletion guard configuration.
+type deletionGuardInterceptor struct {
+	typeName string
+}
+
+func (r deletionGuardInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || why != Delete {
+		return ctx, diags
+	}
+
+	v, ok := meta.(*conns.AWSClient)
+	if !ok || v.DeletionGuardConfig == nil {
+		return ctx, diags
+	}
+
+	if v.DeletionGuardConfig.Protects(r.typeName, tagsFromRawState(d.GetRawState())) {
+		return ctx, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  conns.DeletionGuardSummary,
+			Detail:   conns.DeletionGuardDetail(r.typeName, d.Id()),
+		})
+	}
+
+	return ctx, diags
+}
+
+// tagsFromRawState returns a resource's `tags_all`, or `tags` if it has no `tags_all` attribute, from its raw state.
+func tagsFromRawState(state cty.Value) map[string]string {
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() {
+		return nil
+	}
+
+	for _, name := range []string{names.AttrTagsAll, names.AttrTags} {
+		if !state.Type().HasAttribute(name) {
+			continue
+		}
+
+		if v := state.GetAttr(name); v.IsWhollyKnown() && v.Type().IsMapType() {
+			return ctyStringMap(v)
+		}
+	}
+
+	return nil
+}
+
+// validResourceTypePattern validates a resource type name pattern, as used by path.Match.
+func validResourceTypePattern(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid resource type pattern: %w", k, value, err))
+	}
+
+	return
+}
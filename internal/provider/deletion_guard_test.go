@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeletionGuardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		typeName    string
+		tags        map[string]string
+		wantBlocked bool
+	}{
+		"matching": {
+			typeName:    "aws_test",
+			tags:        map[string]string{"Protected": "true"},
+			wantBlocked: true,
+		},
+		"non-matching resource type": {
+			typeName: "aws_other",
+			tags:     map[string]string{"Protected": "true"},
+		},
+		"non-matching tags": {
+			typeName: "aws_test",
+			tags:     map[string]string{"Protected": "false"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var deleted bool
+			noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				return nil
+			}
+			wrapped := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, _ any) context.Context {
+					return ctx
+				},
+				interceptors: interceptorItems{
+					{
+						when:        Before,
+						why:         Delete,
+						interceptor: deletionGuardInterceptor{typeName: testCase.typeName},
+					},
+				},
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					names.AttrTagsAll: {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				CreateWithoutTimeout: noop,
+				ReadWithoutTimeout:   noop,
+				DeleteWithoutTimeout: wrapped.Delete(func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+					deleted = true
+
+					return nil
+				}),
+			}
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					testCase.typeName: r,
+				},
+			}
+			provider.SetMeta(&conns.AWSClient{
+				DeletionGuardConfig: &conns.DeletionGuardConfig{
+					ResourceTypes: []string{"aws_test*"},
+					Tags:          map[string]string{"Protected": "true"},
+				},
+			})
+
+			typ := r.CoreConfigSchema().ImpliedType()
+			elems := make(map[string]cty.Value)
+			for k, v := range testCase.tags {
+				elems[k] = cty.StringVal(v)
+			}
+			prior := cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.StringVal("test-id"),
+				names.AttrTags:    cty.MapVal(elems),
+				names.AttrTagsAll: cty.MapVal(elems),
+			})
+
+			response, err := provider.GRPCProvider().ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+				TypeName:     testCase.typeName,
+				PriorState:   dynamicValue(t, prior, typ),
+				PlannedState: dynamicValue(t, cty.NullVal(typ), typ),
+				Config:       dynamicValue(t, cty.NullVal(typ), typ),
+			})
+			if err != nil {
+				t.Fatalf("applying: %s", err)
+			}
+
+			if testCase.wantBlocked {
+				if len(response.Diagnostics) != 1 {
+					t.Fatalf("diagnostics = %v, want 1", response.Diagnostics)
+				}
+				if got, want := response.Diagnostics[0].Summary, conns.DeletionGuardSummary; got != want {
+					t.Errorf("summary = %q, want %q", got, want)
+				}
+				if deleted {
+					t.Errorf("resource deleted")
+				}
+
+				return
+			}
+
+			if len(response.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+			}
+			if !deleted {
+				t.Errorf("resource not deleted")
+			}
+		})
+	}
+}
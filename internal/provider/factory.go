@@ -24,7 +24,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		newPolicyDiffProviderServer(ctx, primary, newDefaultTimeoutsProviderServer(ctx, primary, newRequiredTagsProviderServer(ctx, primary))),
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -34,5 +34,5 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	return newIAMPreflightProviderServer(ctx, primary, muxServer.ProviderServer), primary, nil
 }
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// defaultTimeoutsInterceptor makes the provider's default timeouts for the resource available to its CRUD handlers.
+// The framework.WithTimeouts methods use these in preference to the resource's own defaults.
+type defaultTimeoutsInterceptor struct {
+	typeName string
+}
+
+func (r defaultTimeoutsInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.newContext(ctx, meta, when), diags
+}
+
+func (r defaultTimeoutsInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.newContext(ctx, meta, when), diags
+}
+
+func (r defaultTimeoutsInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.newContext(ctx, meta, when), diags
+}
+
+func (r defaultTimeoutsInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.newContext(ctx, meta, when), diags
+}
+
+func (r defaultTimeoutsInterceptor) newContext(ctx context.Context, meta *conns.AWSClient, when when) context.Context {
+	if when != Before || meta == nil {
+		return ctx
+	}
+
+	if v, ok := meta.DefaultTimeoutsConfig.For(r.typeName); ok {
+		ctx = conns.NewDefaultTimeoutsContext(ctx, v)
+	}
+
+	return ctx
+}
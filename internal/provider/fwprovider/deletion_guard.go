@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionGuardInterceptor prevents the deletion of resources that match the provider's deletion guard configuration.
+type deletionGuardInterceptor struct {
+	typeName string
+}
+
+func (r deletionGuardInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r deletionGuardInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || meta == nil || meta.DeletionGuardConfig == nil {
+		return ctx, diags
+	}
+
+	if meta.DeletionGuardConfig.Protects(r.typeName, tagsFromRaw(request.State.Raw)) {
+		diags.AddError(conns.DeletionGuardSummary, conns.DeletionGuardDetail(r.typeName, idFromRaw(request.State.Raw)))
+	}
+
+	return ctx, diags
+}
+
+// tagsFromRaw returns the `tags_all`, or `tags` if there is no `tags_all` attribute, of the specified raw object value.
+func tagsFromRaw(raw tftypes.Value) map[string]string {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return nil
+	}
+
+	for _, name := range []string{names.AttrTagsAll, names.AttrTags} {
+		v, ok := m[name]
+		if !ok || v.IsNull() || !v.IsFullyKnown() {
+			continue
+		}
+
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			continue
+		}
+
+		tags := make(map[string]string, len(elements))
+		for k, v := range elements {
+			var s string
+			if err := v.As(&s); err == nil && !v.IsNull() {
+				tags[k] = s
+			}
+		}
+
+		return tags
+	}
+
+	return nil
+}
+
+// resourceTypePatternValidator validates that a string Attribute's value is a resource type name pattern, as used by path.Match.
+type resourceTypePatternValidator struct{}
+
+func (v resourceTypePatternValidator) Description(_ context.Context) string {
+	return "value must be a valid resource type name pattern"
+}
+
+func (v resourceTypePatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v resourceTypePatternValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := path.Match(request.ConfigValue.ValueString(), ""); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeletionGuardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		typeName    string
+		tags        map[string]string
+		wantBlocked bool
+	}{
+		"matching": {
+			typeName:    "aws_test",
+			tags:        map[string]string{"Protected": "true"},
+			wantBlocked: true,
+		},
+		"non-matching resource type": {
+			typeName: "aws_other",
+			tags:     map[string]string{"Protected": "true"},
+		},
+		"non-matching tags": {
+			typeName: "aws_test",
+			tags:     map[string]string{"Protected": "false"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inner := &deleteRecordingResource{testResource: testResource{typeName: testCase.typeName}}
+			w := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, _ *conns.AWSClient) context.Context {
+					return ctx
+				},
+				inner:        inner,
+				interceptors: resourceInterceptors{deletionGuardInterceptor{typeName: testCase.typeName}},
+				meta: &conns.AWSClient{
+					DeletionGuardConfig: &conns.DeletionGuardConfig{
+						ResourceTypes: []string{"aws_test*"},
+						Tags:          map[string]string{"Protected": "true"},
+					},
+				},
+			}
+
+			resourceSchema := testResourceSchema()
+			typ := resourceSchema.Type().TerraformType(ctx)
+			tagsType := tftypes.Map{ElementType: tftypes.String}
+			elems := make(map[string]tftypes.Value)
+			for k, v := range testCase.tags {
+				elems[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			request := resource.DeleteRequest{
+				State: tfsdk.State{
+					Schema: resourceSchema,
+					Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+						names.AttrID:      tftypes.NewValue(tftypes.String, "test-id"),
+						names.AttrTags:    tftypes.NewValue(tagsType, elems),
+						names.AttrTagsAll: tftypes.NewValue(tagsType, elems),
+					}),
+				},
+			}
+			response := resource.DeleteResponse{
+				State: tfsdk.State{
+					Schema: resourceSchema,
+					Raw:    request.State.Raw.Copy(),
+				},
+			}
+
+			w.Delete(ctx, request, &response)
+
+			if testCase.wantBlocked {
+				if got, want := response.Diagnostics.ErrorsCount(), 1; got != want {
+					t.Fatalf("errors = %d, want %d: %v", got, want, response.Diagnostics)
+				}
+				if got, want := response.Diagnostics.Errors()[0].Summary(), conns.DeletionGuardSummary; got != want {
+					t.Errorf("summary = %q, want %q", got, want)
+				}
+				if inner.deleted {
+					t.Errorf("resource deleted")
+				}
+
+				return
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+			}
+			if !inner.deleted {
+				t.Errorf("resource not deleted")
+			}
+		})
+	}
+}
+
+// deleteRecordingResource is a resource that records whether it has been deleted.
+type deleteRecordingResource struct {
+	testResource
+	deleted bool
+}
+
+func (r *deleteRecordingResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+	r.deleted = true
+}
@@ -6,12 +6,18 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -148,13 +154,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regional is true if the top-level `region` attribute is injected into the data source's schema.
+	regional bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regional bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regional:         regional,
 	}
 }
 
@@ -166,12 +175,67 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		// Copy the attributes so that the inner schema is unchanged.
+		attributes := maps.Clone(response.Schema.Attributes)
+		if attributes == nil {
+			attributes = make(map[string]dsschema.Attribute)
+		}
+		attributes[names.AttrRegion] = dataSourceRegionAttribute()
+		response.Schema.Attributes = attributes
+	}
+}
+
+// innerSchema returns the schema of the wrapped data source, without any injected attributes.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) dsschema.Schema {
+	response := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if !w.regional {
+		// TODO Run interceptors.
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	region := regionFromRaw(request.Config.Raw)
+	if region == "" {
+		region = defaultRegion(w.meta)
+	}
+	setOverrideRegion(ctx, region, w.meta)
+
+	innerSchema := w.innerSchema(ctx)
+	innerType := innerSchema.Type().TerraformType(ctx)
+
+	raw, diags := withoutRegion(request.Config.Raw, innerType)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	request.Config = tfsdk.Config{Schema: innerSchema, Raw: raw}
+
+	outerState := response.State
+	raw, diags = withoutRegion(outerState.Raw, innerType)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.State = tfsdk.State{Schema: innerSchema, Raw: raw}
+
 	// TODO Run interceptors.
 	w.inner.Read(ctx, request, response)
+
+	raw, diags = withRegion(response.State.Raw, outerState.Raw.Type(), regionValue(region))
+	response.Diagnostics.Append(diags...)
+	outerState.Raw = raw
+	response.State = outerState
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -199,14 +263,31 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regional is true if the top-level `region` attribute is injected into the resource's schema.
+	regional bool
+	// tagged is true if the resource has opted in to transparent tagging.
+	tagged bool
+
+	policyAttributeNamesOnce sync.Once
+	// policyAttributeNames contains the sorted names of the resource's top-level IAM policy attributes.
+	policyAttributeNames []string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regional bool) resource.ResourceWithConfigure {
+	w := &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		regional:         regional,
+	}
+
+	for _, v := range interceptors {
+		if _, ok := v.(tagsResourceInterceptor); ok {
+			w.tagged = true
+		}
 	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -217,6 +298,32 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regional {
+		// Copy the attributes so that the inner schema is unchanged.
+		attributes := maps.Clone(response.Schema.Attributes)
+		if attributes == nil {
+			attributes = make(map[string]rschema.Attribute)
+		}
+		attributes[names.AttrRegion] = resourceRegionAttribute()
+		response.Schema.Attributes = attributes
+	}
+}
+
+// regionConverter returns a converter between the wrapper's schema and the schema of the wrapped resource.
+func (w *wrappedResource) regionConverter(ctx context.Context) *resourceRegionConverter {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return newResourceRegionConverter(ctx, response.Schema)
+}
+
+// regionOrDefault returns the specified Region, or the provider's configured Region if none is specified.
+func (w *wrappedResource) regionOrDefault(region string) string {
+	if region == "" {
+		return defaultRegion(w.meta)
+	}
+	return region
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -225,8 +332,31 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	setResourceID(ctx, func() string { return idFromRaw(response.State.Raw) })
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	region := w.regionOrDefault(regionFromRaw(request.Plan.Raw))
+	setOverrideRegion(ctx, region, w.meta)
+
+	c := w.regionConverter(ctx)
+	request.Config, request.Plan = c.config(request.Config), c.plan(request.Plan)
+	outer := response.State
+	response.State = c.state(outer)
+	if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+		response.State = outer
+		return
+	}
+
 	diags := interceptedHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	response.State = c.outerState(response.State, outer, regionValue(region))
+	response.Diagnostics.Append(c.diags...)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -235,8 +365,32 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	setResourceID(ctx, func() string { return idFromRaw(response.State.Raw) })
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	// Existing state from before the `region` attribute was introduced is upgraded here.
+	region := w.regionOrDefault(regionFromRaw(request.State.Raw))
+	setOverrideRegion(ctx, region, w.meta)
+
+	c := w.regionConverter(ctx)
+	request.State = c.state(request.State)
+	outer := response.State
+	response.State = c.state(outer)
+	if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+		response.State = outer
+		return
+	}
+
 	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	response.State = c.outerState(response.State, outer, regionValue(region))
+	response.Diagnostics.Append(c.diags...)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -245,8 +399,31 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	setResourceID(ctx, func() string { return idFromRaw(response.State.Raw) })
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	region := w.regionOrDefault(regionFromRaw(request.Plan.Raw))
+	setOverrideRegion(ctx, region, w.meta)
+
+	c := w.regionConverter(ctx)
+	request.Config, request.Plan, request.State = c.config(request.Config), c.plan(request.Plan), c.state(request.State)
+	outer := response.State
+	response.State = c.state(outer)
+	if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+		response.State = outer
+		return
+	}
+
 	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	response.State = c.outerState(response.State, outer, regionValue(region))
+	response.Diagnostics.Append(c.diags...)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -255,8 +432,62 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	setResourceID(ctx, func() string { return idFromRaw(request.State.Raw) })
+
+	if !w.regional {
+		diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+
+		return
+	}
+
+	region := w.regionOrDefault(regionFromRaw(request.State.Raw))
+	setOverrideRegion(ctx, region, w.meta)
+
+	c := w.regionConverter(ctx)
+	request.State = c.state(request.State)
+	outer := response.State
+	response.State = c.state(outer)
+	if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+		response.State = outer
+		return
+	}
+
 	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
+	response.State = c.outerState(response.State, outer, regionValue(region))
+	response.Diagnostics.Append(c.diags...)
+}
+
+// setResourceID records, in Context, a function returning the ID of the resource being operated on.
+func setResourceID(ctx context.Context, id func() string) {
+	if v, ok := conns.FromContext(ctx); ok {
+		v.ResourceID = id
+	}
+}
+
+// idFromRaw returns the `id` attribute of the specified raw object value, or "" if not known.
+func idFromRaw(raw tftypes.Value) string {
+	if raw.IsNull() || !raw.IsKnown() {
+		return ""
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return ""
+	}
+
+	v, ok := m[names.AttrID]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+
+	var id string
+	if err := v.As(&id); err != nil {
+		return ""
+	}
+
+	return id
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -270,8 +501,39 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if !w.regional {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// The import ID can have an optional `@region` suffix.
+		id, region := conns.SplitImportIDRegion(request.ID)
+		region = w.regionOrDefault(region)
+		request.ID = id
+		setOverrideRegion(ctx, region, w.meta)
+
+		c := w.regionConverter(ctx)
+		outer := response.State
+		response.State = c.state(outer)
+		if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+			response.State = outer
+			return
+		}
+		empty := response.State.Raw
+
 		v.ImportState(ctx, request, response)
 
+		// Preserve the framework's detection of an empty imported state.
+		if response.State.Raw.Equal(empty) {
+			response.State = outer
+			return
+		}
+
+		response.State = c.outerState(response.State, outer, regionValue(region))
+		response.Diagnostics.Append(c.diags...)
+
 		return
 	}
 
@@ -282,12 +544,73 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	w.modifyPlan(ctx, request, response)
+
+	if w.tagged && !response.Diagnostics.HasError() {
+		response.Diagnostics.Append(w.checkRequiredTags(ctx, request.State, response.Plan)...)
+	}
+
+	if !response.Diagnostics.HasError() {
+		response.Diagnostics.Append(w.policyDiffs(ctx, request.State, response.Plan)...)
+	}
+}
+
+func (w *wrappedResource) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !w.regional {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			v.ModifyPlan(ctx, request, response)
+		}
 
 		return
 	}
+
+	var planned tftypes.Value
+	if request.Plan.Raw.IsNull() {
+		// Destroy.
+		region := w.regionOrDefault(regionFromRaw(request.State.Raw))
+		setOverrideRegion(ctx, region, w.meta)
+		planned = regionValue(region)
+	} else {
+		var config fwtypes.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, regionPath, &config)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if config.IsUnknown() {
+			planned = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+		} else {
+			region := w.regionOrDefault(config.ValueString())
+			setOverrideRegion(ctx, region, w.meta)
+			planned = regionValue(region)
+
+			// A change of Region forces resource replacement.
+			if prior := regionFromRaw(request.State.Raw); prior != "" && prior != region {
+				response.RequiresReplace = append(response.RequiresReplace, regionPath)
+			}
+		}
+	}
+
+	c := w.regionConverter(ctx)
+	outer := response.Plan
+
+	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		request.Config, request.Plan, request.State = c.config(request.Config), c.plan(request.Plan), c.state(request.State)
+		response.Plan = c.plan(outer)
+		if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+			response.Plan = outer
+			return
+		}
+
+		v.ModifyPlan(ctx, request, response)
+	} else {
+		response.Plan = c.plan(outer)
+	}
+
+	response.Plan = c.outerPlan(response.Plan, outer, planned)
+	response.Diagnostics.Append(c.diags...)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -302,6 +625,15 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.regional {
+			c := w.regionConverter(ctx)
+			request.Config = c.config(request.Config)
+			if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -309,8 +641,33 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if !w.regional {
+			return upgraders
+		}
+
+		// Upgraded state has no Region. It is set by the subsequent Read.
+		for version, upgrader := range upgraders {
+			f := upgrader.StateUpgrader
+			upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				c := w.regionConverter(ctx)
+				outer := response.State
+				response.State = c.state(outer)
+				if response.Diagnostics.Append(c.diags...); response.Diagnostics.HasError() {
+					response.State = outer
+					return
+				}
+
+				f(ctx, request, response)
+
+				response.State = c.outerState(response.State, outer, regionValue(""))
+				response.Diagnostics.Append(c.diags...)
+			}
+			upgraders[version] = upgrader
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
@@ -346,7 +703,7 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		}
 
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags), tagsInContext.ResourceType)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -455,7 +812,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 		stateTags := tftags.Null
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
-		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, diags).Map(); len(v) > 0 {
+		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, diags, tagsInContext.ResourceType).Map(); len(v) > 0 {
 			stateTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, v)
 		}
 		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
@@ -516,7 +873,7 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 		}
 
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags), tagsInContext.ResourceType)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+// policyDiffs returns a warning describing, statement by statement, the planned changes to each top-level
+// IAM policy attribute of a resource being updated.
+// IAM policy attributes are those of type fwtypes.IAMPolicyType.
+func (w *wrappedResource) policyDiffs(ctx context.Context, state tfsdk.State, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Create or destroy.
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return diags
+	}
+
+	// The resource's schema doesn't change, so its IAM policy attributes are found once.
+	w.policyAttributeNamesOnce.Do(func() {
+		response := resource.SchemaResponse{}
+		w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+		for name, v := range response.Schema.Attributes {
+			if v, ok := v.(schema.StringAttribute); ok && fwtypes.IAMPolicyType.Equal(v.CustomType) {
+				w.policyAttributeNames = append(w.policyAttributeNames, name)
+			}
+		}
+		slices.Sort(w.policyAttributeNames)
+	})
+
+	for _, name := range w.policyAttributeNames {
+		var old, new fwtypes.IAMPolicy
+		if d := state.GetAttribute(ctx, path.Root(name), &old); d.HasError() {
+			continue
+		}
+		if d := plan.GetAttribute(ctx, path.Root(name), &new); d.HasError() {
+			continue
+		}
+
+		if old.IsNull() || old.IsUnknown() || new.IsNull() || new.IsUnknown() {
+			continue
+		}
+
+		if equal, _ := old.StringSemanticEquals(ctx, new); equal {
+			continue
+		}
+
+		diff, err := iampolicy.Diff(old.ValueString(), new.ValueString())
+		if err != nil {
+			tflog.Debug(ctx, "describing IAM policy changes", map[string]any{
+				"attribute": name,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		if diff == "" {
+			continue
+		}
+
+		diags.AddAttributeWarning(path.Root(name), iampolicy.DiffSummary, iampolicy.DiffDetail(name, diff))
+	}
+
+	return diags
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyDiffs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		oldPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		newPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	)
+
+	inner := &policyTestResource{}
+	w := &wrappedResource{
+		inner: inner,
+	}
+
+	resourceSchema := inner.schema()
+	typ := resourceSchema.Type().TerraformType(ctx)
+	value := func(policy string) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			names.AttrID: tftypes.NewValue(tftypes.String, "test-id"),
+			"policy":     tftypes.NewValue(tftypes.String, policy),
+		})
+	}
+	state := tfsdk.State{
+		Schema: resourceSchema,
+		Raw:    value(oldPolicy),
+	}
+	plan := tfsdk.Plan{
+		Schema: resourceSchema,
+		Raw:    value(newPolicy),
+	}
+
+	for i := 0; i < 2; i++ {
+		diags := w.policyDiffs(ctx, state, plan)
+
+		if got, want := diags.WarningsCount(), 1; got != want {
+			t.Fatalf("warnings = %d, want %d: %v", got, want, diags)
+		}
+		if got, want := diags.Warnings()[0].Summary(), iampolicy.DiffSummary; got != want {
+			t.Errorf("summary = %q, want %q", got, want)
+		}
+	}
+
+	if got, want := inner.schemaCalls, 1; got != want {
+		t.Errorf("Schema calls = %d, want %d", got, want)
+	}
+
+	if diags := w.policyDiffs(ctx, state, tfsdk.Plan{Schema: resourceSchema, Raw: value(oldPolicy)}); diags.WarningsCount() > 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+// policyTestResource is a resource with an IAM policy attribute that counts calls to Schema.
+type policyTestResource struct {
+	testResource
+	schemaCalls int
+}
+
+func (r *policyTestResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.schemaCalls++
+	response.Schema = r.schema()
+}
+
+func (r *policyTestResource) schema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"policy": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+		},
+	}
+}
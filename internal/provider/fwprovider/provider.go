@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -56,10 +59,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_audit_log": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which one JSON line is appended for each AWS API call. Request and response bodies are not logged.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"data_source_cache_ttl": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the results of AWS API calls made by cacheable data sources are reused for, e.g. `10m`. Caching is disabled if not set or set to `0s`.",
+				Validators: []validator.String{
+					fwvalidators.Duration(),
+				},
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -80,6 +94,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_preflight": schema.StringAttribute{
+				Optional:    true,
+				Description: "Check at plan time that the caller has the IAM permissions needed to apply each planned resource change. Valid values are `warn` and `error`, the severity of missing permission diagnostics.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -167,9 +185,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume prior to making API calls. Roles are assumed in order, each using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -265,6 +281,87 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tags to default across all resources",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"resource_type_tags": schema.ListNestedBlock{
+							Description: "Configuration block with additional resource tags to default across resources of matching types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"resource_type": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource type name, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_db_*`.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default across resources of matching types",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration block with operation timeouts to default across resources of matching types.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Create timeout, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Delete timeout, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Read timeout, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource type name, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_db_*`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default Update timeout, e.g. `60m`.",
+							Validators: []validator.String{
+								fwvalidators.Duration(),
+							},
+						},
+					},
+				},
+			},
+			"deletion_guard": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent the deletion of matching resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(resourceTypePatternValidator{}),
+							},
+							Description: "Resource type names, which can contain `*` wildcards, e.g. `aws_db_*`, of the resources that cannot be deleted.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags that a resource must have all of to be prevented from being deleted. If omitted, all resources of matching types are protected.",
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -288,6 +385,69 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"required_tags": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to require resource tags across all taggable resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "How resources whose planned tags do not comply are reported. Valid values are `warn` and `error`. Defaults to `warn`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(tftags.RequiredTagsModes()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.SetNestedBlock{
+							Description: "Tag required on all taggable resources.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions matching the allowed tag values. A value is allowed if it wholly matches any of the expressions. If omitted, any value is allowed.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_retry": schema.ListNestedBlock{
+				Description: "Configuration block with a retry and rate limit policy for the API clients of a single service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an API request to the service is attempted. Overrides `max_retries`.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum time to wait between attempts, e.g. `30s`.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum rate at which API requests, including retries, are sent to the service.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional API error codes that are retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, as used in `endpoints`, e.g. `ec2`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,9 +493,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, v.Cacheable)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig, typeName)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -343,12 +503,12 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if !v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
@@ -362,8 +522,11 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			// Regional data sources get a top-level `region` attribute.
+			regional := isRegionalDataSource(servicePackageName, schemaResponse.Schema)
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regional)
 			})
 		}
 	}
@@ -404,9 +567,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig, typeName)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -414,12 +577,12 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
 				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
 					if v.IsComputed() {
 						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
@@ -442,8 +605,17 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			// Resources matching the provider's deletion_guard configuration cannot be deleted.
+			interceptors = append(interceptors, deletionGuardInterceptor{typeName: typeName})
+
+			// Resources matching the provider's default_timeouts configuration use those timeouts by default.
+			interceptors = append(interceptors, defaultTimeoutsInterceptor{typeName: typeName})
+
+			// Regional resources get a top-level `region` attribute.
+			regional := isRegionalResource(servicePackageName, schemaResponse.Schema)
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, regional)
 			})
 		}
 	}
@@ -466,6 +638,12 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsForAZsFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewS3URIParseFunction,
+		tffunction.NewTagsMergeFunction,
+		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
 
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The per-resource `region` attribute is not visible to the wrapped resource or data source.
+// The wrapper strips the attribute from all requests before calling the inner implementation and
+// adds it back to all responses so that existing models (structs with `tfsdk` tags) need no changes.
+
+var (
+	regionPath   = path.Root(names.AttrRegion)
+	regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+var regionValidators = []validator.String{
+	stringvalidator.RegexMatches(regionRegexp, "must be a valid AWS Region name"),
+}
+
+// resourceRegionAttribute returns the schema of the top-level `region` attribute injected into regional resources.
+func resourceRegionAttribute() rschema.StringAttribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators,
+	}
+}
+
+// dataSourceRegionAttribute returns the schema of the top-level `region` attribute injected into regional data sources.
+func dataSourceRegionAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators,
+	}
+}
+
+// isRegionalResource returns whether the `region` attribute should be injected into a resource with the specified schema.
+func isRegionalResource(servicePackageName string, s rschema.Schema) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+	if _, ok := s.Attributes[names.AttrRegion]; ok {
+		return false
+	}
+	if _, ok := s.Blocks[names.AttrRegion]; ok {
+		return false
+	}
+	return true
+}
+
+// isRegionalDataSource returns whether the `region` attribute should be injected into a data source with the specified schema.
+func isRegionalDataSource(servicePackageName string, s dsschema.Schema) bool {
+	if names.IsGlobalService(servicePackageName) {
+		return false
+	}
+	if _, ok := s.Attributes[names.AttrRegion]; ok {
+		return false
+	}
+	if _, ok := s.Blocks[names.AttrRegion]; ok {
+		return false
+	}
+	return true
+}
+
+// setOverrideRegion records the per-resource AWS Region in Context so that API clients are configured for that Region.
+func setOverrideRegion(ctx context.Context, region string, meta *conns.AWSClient) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if meta != nil && region == meta.Region {
+		region = ""
+	}
+
+	inContext.OverrideRegion = region
+}
+
+// defaultRegion returns the provider's configured Region.
+func defaultRegion(meta *conns.AWSClient) string {
+	if meta == nil {
+		return ""
+	}
+	return meta.Region
+}
+
+// regionFromRaw returns the value of the `region` attribute from a raw object value.
+// An empty string is returned if the value is null, unknown or has no such attribute.
+func regionFromRaw(raw tftypes.Value) string {
+	if raw.IsNull() || !raw.IsKnown() {
+		return ""
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		return ""
+	}
+
+	v, ok := m[names.AttrRegion]
+	if !ok || v.IsNull() || !v.IsKnown() {
+		return ""
+	}
+
+	var region string
+	if err := v.As(&region); err != nil {
+		return ""
+	}
+
+	return region
+}
+
+// withoutRegion returns a raw object value of the specified type with the `region` attribute removed.
+func withoutRegion(raw tftypes.Value, typ tftypes.Type) (tftypes.Value, diag.Diagnostics) {
+	return transformRegion(raw, typ, func(m map[string]tftypes.Value) {
+		delete(m, names.AttrRegion)
+	})
+}
+
+// withRegion returns a raw object value of the specified type with the `region` attribute added.
+func withRegion(raw tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	return transformRegion(raw, typ, func(m map[string]tftypes.Value) {
+		m[names.AttrRegion] = region
+	})
+}
+
+// regionValue returns a raw string value for the specified Region. A null value is returned if region is empty.
+func regionValue(region string) tftypes.Value {
+	if region == "" {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+	return tftypes.NewValue(tftypes.String, region)
+}
+
+func transformRegion(raw tftypes.Value, typ tftypes.Type, f func(map[string]tftypes.Value)) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !raw.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), diags
+	}
+	if raw.IsNull() {
+		return tftypes.NewValue(typ, nil), diags
+	}
+
+	var m map[string]tftypes.Value
+	if err := raw.As(&m); err != nil {
+		diags.AddError("Converting Region", fmt.Sprintf("converting object value: %s", err))
+		return raw, diags
+	}
+
+	f(m)
+
+	if err := tftypes.ValidateValue(typ, m); err != nil {
+		diags.AddError("Converting Region", fmt.Sprintf("converting object value: %s", err))
+		return raw, diags
+	}
+
+	return tftypes.NewValue(typ, m), diags
+}
+
+// resourceRegionConverter converts request and response values between the wrapper's schema, which includes the
+// `region` attribute, and the wrapped resource's schema.
+type resourceRegionConverter struct {
+	diags  diag.Diagnostics
+	schema rschema.Schema
+	typ    tftypes.Type
+}
+
+func newResourceRegionConverter(ctx context.Context, schema rschema.Schema) *resourceRegionConverter {
+	return &resourceRegionConverter{
+		schema: schema,
+		typ:    schema.Type().TerraformType(ctx),
+	}
+}
+
+func (c *resourceRegionConverter) config(v tfsdk.Config) tfsdk.Config {
+	raw, diags := withoutRegion(v.Raw, c.typ)
+	c.diags.Append(diags...)
+
+	return tfsdk.Config{Schema: c.schema, Raw: raw}
+}
+
+func (c *resourceRegionConverter) plan(v tfsdk.Plan) tfsdk.Plan {
+	raw, diags := withoutRegion(v.Raw, c.typ)
+	c.diags.Append(diags...)
+
+	return tfsdk.Plan{Schema: c.schema, Raw: raw}
+}
+
+func (c *resourceRegionConverter) state(v tfsdk.State) tfsdk.State {
+	raw, diags := withoutRegion(v.Raw, c.typ)
+	c.diags.Append(diags...)
+
+	return tfsdk.State{Schema: c.schema, Raw: raw}
+}
+
+// outerPlan returns the outer plan with its value replaced by the inner plan's value plus the `region` attribute.
+func (c *resourceRegionConverter) outerPlan(inner, outer tfsdk.Plan, region tftypes.Value) tfsdk.Plan {
+	raw, diags := withRegion(inner.Raw, outer.Raw.Type(), region)
+	c.diags.Append(diags...)
+	outer.Raw = raw
+
+	return outer
+}
+
+// outerState returns the outer state with its value replaced by the inner state's value plus the `region` attribute.
+func (c *resourceRegionConverter) outerState(inner, outer tfsdk.State, region tftypes.Value) tfsdk.State {
+	raw, diags := withRegion(inner.Raw, outer.Raw.Type(), region)
+	c.diags.Append(diags...)
+	outer.Raw = raw
+
+	return outer
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// checkRequiredTags returns a diagnostic if the planned `tags_all` of a resource being created, or whose tags are changing,
+// does not comply with the provider's required tags configuration.
+// Resources whose tags are not yet known are not checked.
+func (w *wrappedResource) checkRequiredTags(ctx context.Context, state tfsdk.State, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if w.meta == nil || w.meta.RequiredTagsConfig == nil {
+		return diags
+	}
+
+	// Destroy.
+	if plan.Raw.IsNull() {
+		return diags
+	}
+
+	var planTagsAll types.Map
+	if diags.Append(plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &planTagsAll)...); diags.HasError() {
+		return diags
+	}
+
+	var id string
+	if !state.Raw.IsNull() {
+		var stateTagsAll types.Map
+		if diags.Append(state.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...); diags.HasError() {
+			return diags
+		}
+
+		if planTagsAll.Equal(stateTagsAll) {
+			return diags
+		}
+
+		// Not all resources have an `id` attribute.
+		var v types.String
+		if !state.GetAttribute(ctx, path.Root(names.AttrID), &v).HasError() {
+			id = v.ValueString()
+		}
+	}
+
+	metadataResponse := resource.MetadataResponse{}
+	w.inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+	typeName := metadataResponse.TypeName
+
+	var tags tftags.KeyValueTags
+	if !planTagsAll.IsUnknown() && !mapHasUnknownElements(planTagsAll) {
+		tags = tftags.New(ctx, planTagsAll)
+	} else {
+		// `tags_all` is computed if not all tags are known, or for some resources, on create.
+		// Use the merged resource and default tags if the resource tags are known.
+		var planTags types.Map
+		if diags.Append(plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...); diags.HasError() {
+			return diags
+		}
+
+		if planTags.IsUnknown() || mapHasUnknownElements(planTags) {
+			return diags
+		}
+
+		tags = w.meta.DefaultTagsConfig.MergeTags(tftags.New(ctx, planTags), typeName).IgnoreConfig(w.meta.IgnoreTagsConfig)
+	}
+
+	if err := w.meta.RequiredTagsConfig.Validate(tags); err != nil {
+		detail := tftags.RequiredTagsDetail(typeName, id, err)
+
+		if w.meta.RequiredTagsConfig.IsError() {
+			diags.AddAttributeError(path.Root(names.AttrTags), tftags.RequiredTagsSummary, detail)
+		} else {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), tftags.RequiredTagsSummary, detail)
+		}
+	}
+
+	return diags
+}
+
+func mapHasUnknownElements(m types.Map) bool {
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
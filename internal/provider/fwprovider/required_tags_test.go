@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestCheckRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+	tagsVal := func(m map[string]string) tftypes.Value {
+		if m == nil {
+			return tftypes.NewValue(tagsType, tftypes.UnknownValue)
+		}
+
+		elems := make(map[string]tftypes.Value)
+		for k, v := range m {
+			elems[k] = tftypes.NewValue(tftypes.String, v)
+		}
+
+		return tftypes.NewValue(tagsType, elems)
+	}
+
+	testCases := map[string]struct {
+		defaultTags map[string]string
+		priorTags   map[string]string // nil if creating.
+		tags        map[string]string
+		tagsAll     map[string]string // nil if unknown.
+		wantDetail  string
+	}{
+		"create compliant": {
+			tags:    map[string]string{"CostCenter": "1234"},
+			tagsAll: map[string]string{"CostCenter": "1234"},
+		},
+		"create non-compliant": {
+			tags:       map[string]string{"Owner": "team-a"},
+			tagsAll:    map[string]string{"Owner": "team-a"},
+			wantDetail: "this aws_test resource does not comply",
+		},
+		"create unknown tags_all compliant with default tags": {
+			defaultTags: map[string]string{"CostCenter": "1234"},
+			tags:        map[string]string{"Owner": "team-a"},
+		},
+		"create unknown tags_all non-compliant": {
+			tags:       map[string]string{"Owner": "team-a"},
+			wantDetail: "this aws_test resource does not comply",
+		},
+		"update non-compliant": {
+			priorTags:  map[string]string{"CostCenter": "1234"},
+			tags:       map[string]string{"Owner": "team-a"},
+			tagsAll:    map[string]string{"Owner": "team-a"},
+			wantDetail: "this aws_test resource (test-id) does not comply",
+		},
+		"update unchanged": {
+			priorTags: map[string]string{"Owner": "team-a"},
+			tags:      map[string]string{"Owner": "team-a"},
+			tagsAll:   map[string]string{"Owner": "team-a"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w := &wrappedResource{
+				inner: &testResource{typeName: "aws_test"},
+				meta: &conns.AWSClient{
+					DefaultTagsConfig: &tftags.DefaultConfig{
+						Tags: tftags.New(ctx, testCase.defaultTags),
+					},
+					IgnoreTagsConfig: &tftags.IgnoreConfig{},
+					RequiredTagsConfig: &tftags.RequiredConfig{
+						Tags: map[string][]*regexp.Regexp{
+							"CostCenter": nil,
+						},
+					},
+				},
+				tagged: true,
+			}
+
+			resourceSchema := testResourceSchema()
+			typ := resourceSchema.Type().TerraformType(ctx)
+			state := tfsdk.State{
+				Schema: resourceSchema,
+				Raw:    tftypes.NewValue(typ, nil),
+			}
+			id := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			if testCase.priorTags != nil {
+				id = tftypes.NewValue(tftypes.String, "test-id")
+				state.Raw = tftypes.NewValue(typ, map[string]tftypes.Value{
+					names.AttrID:      id,
+					names.AttrTags:    tagsVal(testCase.priorTags),
+					names.AttrTagsAll: tagsVal(testCase.priorTags),
+				})
+			}
+			plan := tfsdk.Plan{
+				Schema: resourceSchema,
+				Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+					names.AttrID:      id,
+					names.AttrTags:    tagsVal(testCase.tags),
+					names.AttrTagsAll: tagsVal(testCase.tagsAll),
+				}),
+			}
+
+			diags := w.checkRequiredTags(ctx, state, plan)
+
+			if testCase.wantDetail == "" {
+				if diags.HasError() || diags.WarningsCount() > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				return
+			}
+
+			if got, want := diags.WarningsCount(), 1; got != want {
+				t.Fatalf("warnings = %d, want %d: %v", got, want, diags)
+			}
+			v, ok := diags.Warnings()[0].(diag.DiagnosticWithPath)
+			if !ok {
+				t.Fatalf("warning has no attribute path")
+			}
+			if got, want := v.Path(), path.Root(names.AttrTags); !got.Equal(want) {
+				t.Errorf("path = %s, want %s", got, want)
+			}
+			if got := diags.Warnings()[0].Detail(); !strings.Contains(got, testCase.wantDetail) {
+				t.Errorf("detail = %q, want to contain %q", got, testCase.wantDetail)
+			}
+		})
+	}
+}
+
+// testResource is a resource that does nothing.
+type testResource struct {
+	typeName string
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.typeName
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = testResourceSchema()
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func testResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTagsAll: schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	iamPreflightModeError = "error"
+	iamPreflightModeWarn  = "warn"
+)
+
+func iamPreflightModes() []string {
+	return []string{
+		iamPreflightModeError,
+		iamPreflightModeWarn,
+	}
+}
+
+const (
+	iamPreflightSummary        = "Missing IAM permissions"
+	iamPreflightSkippedSummary = "IAM permission preflight skipped"
+)
+
+// iamPreflightProviderServer wraps the muxed provider server and checks, at plan time, that the caller is allowed
+// to perform the IAM actions that each planned resource change needs.
+// Actions are declared per resource via the IAMActions service package registration metadata and are checked
+// using iam:SimulatePrincipalPolicy, against the resource's ARN when it's known.
+type iamPreflightProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+	// actions contains the declared IAM actions, keyed by resource type name.
+	actions map[string]*types.ServicePackageResourceIAMActions
+
+	// resolvePrincipal and simulate make the AWS API calls needed for the check.
+	resolvePrincipal func(context.Context, *conns.AWSClient) (string, error)
+	simulate         func(context.Context, *conns.AWSClient, string, string, []string) (map[string]bool, error)
+
+	lock sync.Mutex
+	// allowed contains the simulated decision for each IAM action and resource ARN checked.
+	allowed map[iamPreflightCheck]bool
+	// schemas contains the value types of resource schemas, keyed by resource type name.
+	schemas map[string]tftypes.Type
+
+	principalLock sync.Mutex
+	// principalARN is the ARN of the IAM principal used for simulation, empty until resolved.
+	principalARN string
+}
+
+func newIAMPreflightProviderServer(ctx context.Context, provider *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	actions := make(map[string]*types.ServicePackageResourceIAMActions)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.IAMActions != nil {
+				actions[v.TypeName] = v.IAMActions
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.IAMActions == nil {
+				continue
+			}
+
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			actions[response.TypeName] = v.IAMActions
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &iamPreflightProviderServer{
+			ProviderServer:   server(),
+			provider:         provider,
+			actions:          actions,
+			resolvePrincipal: findPrincipalARN,
+			simulate:         simulatePrincipalPolicy,
+			allowed:          make(map[iamPreflightCheck]bool),
+		}
+	}
+}
+
+// iamPreflightCheck identifies a simulated IAM action on a resource.
+// An empty resource ARN means any resource ("*").
+type iamPreflightCheck struct {
+	action      string
+	resourceARN string
+}
+
+func (s *iamPreflightProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	actions, ok := s.actions[request.TypeName]
+	if !ok {
+		return response, err
+	}
+
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || meta.IAMPreflightMode == "" {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, err
+		}
+	}
+
+	if v := s.checkIAMActions(ctx, meta, request, response, actions); v != nil {
+		response.Diagnostics = append(response.Diagnostics, v)
+	}
+
+	return response, err
+}
+
+// checkIAMActions returns a diagnostic if the caller isn't allowed to perform any of the IAM actions needed by the planned change.
+func (s *iamPreflightProviderServer) checkIAMActions(ctx context.Context, meta *conns.AWSClient, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse, actions *types.ServicePackageResourceIAMActions) *tfprotov5.Diagnostic {
+	typ, err := s.resourceType(ctx, request.TypeName)
+	if err != nil {
+		tflog.Warn(ctx, "IAM permission preflight: reading resource schema", map[string]any{
+			"error": err.Error(),
+		})
+		return nil
+	}
+
+	prior, err := request.PriorState.Unmarshal(typ)
+	if err != nil {
+		return nil
+	}
+	planned, err := response.PlannedState.Unmarshal(typ)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		operation string
+		want      []string
+	)
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		return nil
+	case prior.IsNull():
+		operation, want = "create", actions.Create
+	case planned.IsNull():
+		operation, want = "delete", actions.Delete
+	case !prior.Equal(planned):
+		operation, want = "update", actions.Update
+	}
+
+	if len(want) == 0 {
+		return nil
+	}
+
+	// The resource's ARN is known from prior state for updates and deletions, and may be known from configuration for creations.
+	resourceARN := iamPreflightResourceARN(prior)
+	if resourceARN == "" {
+		resourceARN = iamPreflightResourceARN(planned)
+	}
+
+	principalARN, missing, err := s.missingActions(ctx, meta, resourceARN, want)
+	if err != nil {
+		return &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  iamPreflightSkippedSummary,
+			Detail:   fmt.Sprintf("Unable to check the IAM permissions needed to %s %s: %s", operation, iamPreflightResourceName(request.TypeName, prior), err),
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	severity := tfprotov5.DiagnosticSeverityWarning
+	if meta.IAMPreflightMode == iamPreflightModeError {
+		severity = tfprotov5.DiagnosticSeverityError
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "The caller (%s) is not allowed to perform the following IAM actions needed to %s %s:\n", principalARN, operation, iamPreflightResourceName(request.TypeName, prior))
+	for _, v := range missing {
+		fmt.Fprintf(&sb, "\n\t- %s", v)
+	}
+
+	return &tfprotov5.Diagnostic{
+		Severity: severity,
+		Summary:  iamPreflightSummary,
+		Detail:   sb.String(),
+	}
+}
+
+// resourceType returns the value type of the specified resource's schema.
+func (s *iamPreflightProviderServer) resourceType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.schemas == nil {
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		s.schemas = make(map[string]tftypes.Type, len(response.ResourceSchemas))
+		for k, v := range response.ResourceSchemas {
+			s.schemas[k] = v.ValueType()
+		}
+	}
+
+	typ, ok := s.schemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no schema for %s", typeName)
+	}
+
+	return typ, nil
+}
+
+// missingActions returns the ARN of the simulated principal and those of the specified IAM actions that it isn't allowed to perform
+// on the specified resource. An empty resource ARN means any resource.
+// Decisions are cached for the lifetime of the provider server. No lock is held while calling AWS APIs.
+func (s *iamPreflightProviderServer) missingActions(ctx context.Context, meta *conns.AWSClient, resourceARN string, actions []string) (string, []string, error) {
+	principalARN, err := s.principal(ctx, meta)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var unknown []string
+	s.lock.Lock()
+	for _, v := range actions {
+		if _, ok := s.allowed[iamPreflightCheck{action: v, resourceARN: resourceARN}]; !ok {
+			unknown = append(unknown, v)
+		}
+	}
+	s.lock.Unlock()
+
+	if len(unknown) > 0 {
+		allowed, err := s.simulate(ctx, meta, principalARN, resourceARN, unknown)
+		if err != nil {
+			return "", nil, err
+		}
+
+		s.lock.Lock()
+		for k, v := range allowed {
+			s.allowed[iamPreflightCheck{action: k, resourceARN: resourceARN}] = v
+		}
+		s.lock.Unlock()
+	}
+
+	var missing []string
+	s.lock.Lock()
+	for _, v := range actions {
+		if !s.allowed[iamPreflightCheck{action: v, resourceARN: resourceARN}] {
+			missing = append(missing, v)
+		}
+	}
+	s.lock.Unlock()
+	slices.Sort(missing)
+
+	return principalARN, slices.Compact(missing), nil
+}
+
+// principal returns the ARN of the simulated principal, resolving it on first use.
+// Errors aren't cached, so a transient failure is retried by the next check.
+func (s *iamPreflightProviderServer) principal(ctx context.Context, meta *conns.AWSClient) (string, error) {
+	s.principalLock.Lock()
+	defer s.principalLock.Unlock()
+
+	if s.principalARN != "" {
+		return s.principalARN, nil
+	}
+
+	principalARN, err := s.resolvePrincipal(ctx, meta)
+	if err != nil {
+		return "", err
+	}
+
+	s.principalARN = principalARN
+
+	return principalARN, nil
+}
+
+// simulatePrincipalPolicy returns whether the specified principal is allowed to perform each of the specified IAM actions
+// on the specified resource. An empty resource ARN means any resource.
+func simulatePrincipalPolicy(ctx context.Context, meta *conns.AWSClient, principalARN, resourceARN string, actions []string) (map[string]bool, error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     aws.StringSlice(actions),
+		PolicySourceArn: aws.String(principalARN),
+	}
+	if resourceARN != "" {
+		input.ResourceArns = aws.StringSlice([]string{resourceARN})
+	}
+	allowed := make(map[string]bool, len(actions))
+
+	err := meta.IAMConn(ctx).SimulatePrincipalPolicyPagesWithContext(ctx, input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EvaluationResults {
+			allowed[aws.StringValue(v.EvalActionName)] = aws.StringValue(v.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("simulating IAM Principal (%s) Policy: %w", principalARN, err)
+	}
+
+	return allowed, nil
+}
+
+// findPrincipalARN returns the ARN of the IAM user or role making AWS API calls.
+// The ARN of an STS assumed-role session is mapped to the ARN of the assumed IAM role.
+func findPrincipalARN(ctx context.Context, meta *conns.AWSClient) (string, error) {
+	output, err := meta.STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("reading STS Caller Identity: %w", err)
+	}
+
+	callerARN := aws.StringValue(output.Arn)
+	v, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", err
+	}
+
+	if v.Service != names.STSEndpointID {
+		return callerARN, nil
+	}
+
+	parts := strings.Split(v.Resource, "/")
+	if len(parts) < 2 || parts[0] != "assumed-role" {
+		return "", fmt.Errorf("unsupported caller (%s)", callerARN)
+	}
+
+	// The assumed-role ARN doesn't include the role's path.
+	role, err := meta.IAMConn(ctx).GetRoleWithContext(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(parts[1]),
+	})
+	if err != nil {
+		return "", fmt.Errorf("reading IAM Role (%s): %w", parts[1], err)
+	}
+
+	return aws.StringValue(role.Role.Arn), nil
+}
+
+// iamPreflightResourceName returns a description of a resource for diagnostics, including its ID if known.
+func iamPreflightResourceName(typeName string, state tftypes.Value) string {
+	if state.IsNull() || !state.Type().Is(tftypes.Object{}) {
+		return typeName
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := state.As(&attrs); err != nil {
+		return typeName
+	}
+
+	var id string
+	if v, ok := attrs[names.AttrID]; ok && v.IsKnown() && !v.IsNull() {
+		if err := v.As(&id); err != nil || id == "" {
+			return typeName
+		}
+
+		return fmt.Sprintf("%s (%s)", typeName, id)
+	}
+
+	return typeName
+}
+
+// iamPreflightResourceARN returns a resource's ARN from its state, or an empty string if not known.
+func iamPreflightResourceARN(state tftypes.Value) string {
+	if state.IsNull() || !state.Type().Is(tftypes.Object{}) {
+		return ""
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := state.As(&attrs); err != nil {
+		return ""
+	}
+
+	v, ok := attrs[names.AttrARN]
+	if !ok || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var resourceARN string
+	if err := v.As(&resourceARN); err != nil || !arn.IsARN(resourceARN) {
+		return ""
+	}
+
+	return resourceARN
+}
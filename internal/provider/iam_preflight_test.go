@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestIAMPreflightResourceName(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	}}
+
+	testCases := map[string]struct {
+		state tftypes.Value
+		want  string
+	}{
+		"null": {
+			state: tftypes.NewValue(typ, nil),
+			want:  "aws_vpc",
+		},
+		"known ID": {
+			state: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "vpc-12345678"),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			want: "aws_vpc (vpc-12345678)",
+		},
+		"unknown ID": {
+			state: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			want: "aws_vpc",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := iamPreflightResourceName("aws_vpc", testCase.state), testCase.want; got != want {
+				t.Errorf("iamPreflightResourceName = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIAMPreflightResourceARN(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"arn": tftypes.String,
+		"id":  tftypes.String,
+	}}
+
+	testCases := map[string]struct {
+		state tftypes.Value
+		want  string
+	}{
+		"null": {
+			state: tftypes.NewValue(typ, nil),
+		},
+		"known ARN": {
+			state: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"arn": tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/test"),
+				"id":  tftypes.NewValue(tftypes.String, "test"),
+			}),
+			want: "arn:aws:iam::123456789012:role/test",
+		},
+		"unknown ARN": {
+			state: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"arn": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"id":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"invalid ARN": {
+			state: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"arn": tftypes.NewValue(tftypes.String, "test"),
+				"id":  tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"no ARN attribute": {
+			state: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"id": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := iamPreflightResourceARN(testCase.state), testCase.want; got != want {
+				t.Errorf("iamPreflightResourceARN = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// fakeIAMPreflightAPI records calls to the IAM preflight's AWS APIs.
+type fakeIAMPreflightAPI struct {
+	lock           sync.Mutex
+	principalCalls int
+	principalErrs  []error
+	simulateCalls  [][]string
+	resourceARNs   []string
+	simulateErr    error
+	allowed        map[string]bool
+}
+
+func (f *fakeIAMPreflightAPI) resolvePrincipal(context.Context, *conns.AWSClient) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.principalCalls++
+	if len(f.principalErrs) > 0 {
+		err := f.principalErrs[0]
+		f.principalErrs = f.principalErrs[1:]
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "arn:aws:iam::123456789012:role/test", nil
+}
+
+func (f *fakeIAMPreflightAPI) simulate(_ context.Context, _ *conns.AWSClient, _, resourceARN string, actions []string) (map[string]bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.simulateCalls = append(f.simulateCalls, slices.Clone(actions))
+	f.resourceARNs = append(f.resourceARNs, resourceARN)
+	if f.simulateErr != nil {
+		return nil, f.simulateErr
+	}
+
+	allowed := make(map[string]bool, len(actions))
+	for _, v := range actions {
+		allowed[v] = f.allowed[v]
+	}
+
+	return allowed, nil
+}
+
+func newTestIAMPreflightProviderServer(f *fakeIAMPreflightAPI) *iamPreflightProviderServer {
+	return &iamPreflightProviderServer{
+		resolvePrincipal: f.resolvePrincipal,
+		simulate:         f.simulate,
+		allowed:          make(map[iamPreflightCheck]bool),
+	}
+}
+
+func TestIAMPreflightMissingActionsCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := &fakeIAMPreflightAPI{
+		allowed: map[string]bool{
+			"ec2:CreateVpc":      true,
+			"ec2:DescribeVpcs":   true,
+			"ec2:CreateTags":     false,
+			"ec2:DeleteVpc":      true,
+			"ec2:ModifyVpcAttrs": false,
+		},
+	}
+	s := newTestIAMPreflightProviderServer(f)
+
+	principalARN, missing, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc", "ec2:CreateTags", "ec2:DescribeVpcs"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := principalARN, "arn:aws:iam::123456789012:role/test"; got != want {
+		t.Errorf("principal ARN = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff(missing, []string{"ec2:CreateTags"}); diff != "" {
+		t.Errorf("unexpected missing actions diff (+wanted, -got): %s", diff)
+	}
+
+	// Cache hit: no further simulation.
+	_, missing, err = s.missingActions(ctx, nil, "", []string{"ec2:CreateTags", "ec2:CreateVpc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(missing, []string{"ec2:CreateTags"}); diff != "" {
+		t.Errorf("unexpected missing actions diff (+wanted, -got): %s", diff)
+	}
+
+	// Partial cache miss: only the unknown actions are simulated.
+	_, missing, err = s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc", "ec2:DeleteVpc", "ec2:ModifyVpcAttrs"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(missing, []string{"ec2:ModifyVpcAttrs"}); diff != "" {
+		t.Errorf("unexpected missing actions diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := f.principalCalls, 1; got != want {
+		t.Errorf("principal lookups = %d, want %d", got, want)
+	}
+	wantSimulateCalls := [][]string{
+		{"ec2:CreateVpc", "ec2:CreateTags", "ec2:DescribeVpcs"},
+		{"ec2:DeleteVpc", "ec2:ModifyVpcAttrs"},
+	}
+	if diff := cmp.Diff(f.simulateCalls, wantSimulateCalls); diff != "" {
+		t.Errorf("unexpected simulations diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIAMPreflightMissingActionsPrincipalError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := &fakeIAMPreflightAPI{
+		principalErrs: []error{errors.New("throttled")},
+		allowed: map[string]bool{
+			"ec2:CreateVpc": true,
+		},
+	}
+	s := newTestIAMPreflightProviderServer(f)
+
+	if _, _, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := len(f.simulateCalls); got != 0 {
+		t.Errorf("simulations = %d, want 0", got)
+	}
+
+	// The principal lookup error isn't cached.
+	_, missing, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("missing actions = %v, want none", missing)
+	}
+	if got, want := f.principalCalls, 2; got != want {
+		t.Errorf("principal lookups = %d, want %d", got, want)
+	}
+}
+
+func TestIAMPreflightMissingActionsSimulateError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := &fakeIAMPreflightAPI{
+		simulateErr: errors.New("access denied"),
+		allowed: map[string]bool{
+			"ec2:CreateVpc": true,
+		},
+	}
+	s := newTestIAMPreflightProviderServer(f)
+
+	if _, _, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc"}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Failed simulations aren't cached.
+	f.simulateErr = nil
+	_, missing, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(missing) != 0 {
+		t.Errorf("missing actions = %v, want none", missing)
+	}
+	if got, want := len(f.simulateCalls), 2; got != want {
+		t.Errorf("simulations = %d, want %d", got, want)
+	}
+}
+
+func TestIAMPreflightMissingActionsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := &fakeIAMPreflightAPI{
+		allowed: map[string]bool{
+			"ec2:CreateVpc": true,
+		},
+	}
+	s := newTestIAMPreflightProviderServer(f)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, missing, err := s.missingActions(ctx, nil, "", []string{"ec2:CreateVpc", "ec2:DeleteVpc"}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			} else if diff := cmp.Diff(missing, []string{"ec2:DeleteVpc"}); diff != "" {
+				t.Errorf("unexpected missing actions diff (+wanted, -got): %s", diff)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := f.principalCalls, 1; got != want {
+		t.Errorf("principal lookups = %d, want %d", got, want)
+	}
+}
+
+func TestIAMPreflightMissingActionsResourceARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := &fakeIAMPreflightAPI{
+		allowed: map[string]bool{
+			"iam:DeleteRole": true,
+		},
+	}
+	s := newTestIAMPreflightProviderServer(f)
+
+	const (
+		role1 = "arn:aws:iam::123456789012:role/test1"
+		role2 = "arn:aws:iam::123456789012:role/test2"
+	)
+
+	for _, v := range []string{role1, role1, role2, ""} {
+		if _, _, err := s.missingActions(ctx, nil, v, []string{"iam:DeleteRole"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// Decisions are cached per resource.
+	if diff := cmp.Diff(f.resourceARNs, []string{role1, role2, ""}); diff != "" {
+		t.Errorf("unexpected simulated resources diff (+wanted, -got): %s", diff)
+	}
+}
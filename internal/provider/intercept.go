@@ -93,6 +93,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = d.Id
+		}
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -239,7 +242,7 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 		switch why {
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})), tagsInContext.ResourceType)
 			// Remove system tags.
 			tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -362,7 +365,7 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
-			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d).Map()); err != nil {
+			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, tagsInContext.ResourceType).Map()); err != nil {
 				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
 			}
 
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+// policyDiffProviderServer wraps the Plugin SDK provider server and adds a warning describing, statement by statement,
+// the planned changes to each top-level IAM policy attribute of a resource being updated.
+// IAM policy attributes are declared per resource via the IAMPolicyAttributes service package registration metadata.
+type policyDiffProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+	// attributes contains the names of the declared IAM policy attributes, keyed by resource type name.
+	attributes map[string][]string
+}
+
+func newPolicyDiffProviderServer(ctx context.Context, provider *schema.Provider, server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	attributes := make(map[string][]string)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if len(v.IAMPolicyAttributes) > 0 {
+				attributes[v.TypeName] = v.IAMPolicyAttributes
+			}
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &policyDiffProviderServer{
+			ProviderServer: server(),
+			provider:       provider,
+			attributes:     attributes,
+		}
+	}
+}
+
+func (s *policyDiffProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, err
+		}
+	}
+
+	response.Diagnostics = append(response.Diagnostics, s.policyDiffs(ctx, request, response)...)
+
+	return response, err
+}
+
+// policyDiffs returns a warning for each top-level IAM policy attribute whose planned value is not equivalent to its prior value.
+func (s *policyDiffProviderServer) policyDiffs(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) []*tfprotov5.Diagnostic {
+	var diags []*tfprotov5.Diagnostic
+
+	names := s.attributes[request.TypeName]
+	if len(names) == 0 {
+		return diags
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return diags
+	}
+
+	typ := r.CoreConfigSchema().ImpliedType()
+
+	for _, name := range names {
+		prior, ok := attributeFromDynamicValue(request.PriorState, typ, name)
+		// Create.
+		if !ok || !prior.IsKnown() || prior.IsNull() {
+			continue
+		}
+
+		planned, ok := attributeFromDynamicValue(response.PlannedState, typ, name)
+		// Destroy.
+		if !ok || !planned.IsKnown() || planned.IsNull() {
+			continue
+		}
+
+		if prior.Type() != cty.String || planned.Type() != cty.String {
+			continue
+		}
+
+		old, new := prior.AsString(), planned.AsString()
+		if old == new || iampolicy.Equivalent(old, new) {
+			continue
+		}
+
+		diff, err := iampolicy.Diff(old, new)
+		if err != nil {
+			tflog.Debug(ctx, "describing IAM policy changes", map[string]any{
+				"attribute": name,
+				"error":     err.Error(),
+			})
+			continue
+		}
+		if diff == "" {
+			continue
+		}
+
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   iampolicy.DiffSummary,
+			Detail:    iampolicy.DiffDetail(name, diff),
+			Attribute: tftypes.NewAttributePath().WithAttributeName(name),
+		})
+	}
+
+	return diags
+}
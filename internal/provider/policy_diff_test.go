@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyDiffProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		oldPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		newPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	)
+
+	noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return nil
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"document": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+		},
+		CreateWithoutTimeout: noop,
+		ReadWithoutTimeout:   noop,
+		UpdateWithoutTimeout: noop,
+		DeleteWithoutTimeout: noop,
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": r,
+		},
+	}
+	// Only declared attributes are described.
+	server := &policyDiffProviderServer{
+		ProviderServer: provider.GRPCProvider(),
+		provider:       provider,
+		attributes: map[string][]string{
+			"aws_test": {"policy"},
+		},
+	}
+
+	typ := r.CoreConfigSchema().ImpliedType()
+	prior := cty.ObjectVal(map[string]cty.Value{
+		"document":   cty.StringVal(oldPolicy),
+		names.AttrID: cty.StringVal("test-id"),
+		"policy":     cty.StringVal(oldPolicy),
+	})
+	config := cty.ObjectVal(map[string]cty.Value{
+		"document":   cty.StringVal(newPolicy),
+		names.AttrID: cty.NullVal(cty.String),
+		"policy":     cty.StringVal(newPolicy),
+	})
+	proposed := cty.ObjectVal(map[string]cty.Value{
+		"document":   cty.StringVal(newPolicy),
+		names.AttrID: cty.StringVal("test-id"),
+		"policy":     cty.StringVal(newPolicy),
+	})
+
+	response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "aws_test",
+		PriorState:       dynamicValue(t, prior, typ),
+		ProposedNewState: dynamicValue(t, proposed, typ),
+		Config:           dynamicValue(t, config, typ),
+	})
+	if err != nil {
+		t.Fatalf("planning: %s", err)
+	}
+
+	var got []*tfprotov5.Diagnostic
+	for _, v := range response.Diagnostics {
+		if v.Summary == iampolicy.DiffSummary {
+			got = append(got, v)
+		}
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("diagnostics = %v, want 1 policy diff diagnostic", response.Diagnostics)
+	}
+	if got, want := got[0].Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("severity = %v, want %v", got, want)
+	}
+	if got, want := got[0].Attribute, tftypes.NewAttributePath().WithAttributeName("policy"); !got.Equal(want) {
+		t.Errorf("attribute = %v, want %v", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -49,6 +51,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_audit_log": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which one JSON line is appended for each AWS API call. " +
+					"Request and response bodies are not logged.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -58,6 +66,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Can also be configured using the `AWS_CA_BUNDLE` environment variable. " +
 					"(Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"data_source_cache_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "How long the results of AWS API calls made by cacheable data sources are reused for, e.g. `10m`. " +
+					"Caching is disabled if not set or set to `0s`.",
+				ValidateFunc: verify.ValidDuration,
+			},
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -65,6 +80,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"resource_type_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with additional resource tags to default across resources of matching types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource type name, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_db_*`.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across resources of matching types",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +109,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": defaultTimeoutsSchema(),
+			"deletion_guard": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent the deletion of matching resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validResourceTypePattern,
+							},
+							Description: "Resource type names, which can contain `*` wildcards, e.g. `aws_db_*`, of the resources that cannot be deleted.",
+						},
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags that a resource must have all of to be prevented from being deleted. If omitted, all resources of matching types are protected.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -105,6 +167,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_preflight": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Check at plan time that the caller has the IAM permissions needed to apply each planned resource change. " +
+					"Valid values are `warn` and `error`, the severity of missing permission diagnostics.",
+				ValidateFunc: validation.StringInSlice(iamPreflightModes(), false),
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -158,6 +227,42 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all taggable resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "How resources whose planned tags do not comply are reported. Valid values are `warn` and `error`. Defaults to `warn`.",
+							ValidateFunc: validation.StringInSlice(tftags.RequiredTagsModes(), false),
+						},
+						"tag": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Tag required on all taggable resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+										Description: "Regular expressions matching the allowed tag values. A value is allowed if it wholly matches any of the expressions. If omitted, any value is allowed.",
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +290,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_retry": serviceRetrySchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -288,9 +394,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, v.Cacheable)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, typeName)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -322,6 +428,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Regional data sources get a top-level `region` attribute.
+			if !names.IsGlobalService(servicePackageName) && injectRegionSchema(r, dataSourceRegionSchema) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -365,9 +480,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, typeName)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -410,6 +525,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			// Resources matching the provider's deletion_guard configuration cannot be deleted.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before,
+				why:         Delete,
+				interceptor: deletionGuardInterceptor{typeName: typeName},
+			})
+
+			// Regional resources get a top-level `region` attribute.
+			// The region interceptor must run before all others so that they use the resource's Region.
+			regional := !names.IsGlobalService(servicePackageName) && injectRegionSchema(r, resourceRegionSchema)
+			if regional {
+				interceptors = append(interceptorItems{{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				}}, interceptors...)
+			}
+
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
@@ -429,9 +562,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if regional {
+						v = importRegion(v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if regional {
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionInPlan, v)
+				} else {
+					r.CustomizeDiff = setRegionInPlan
+				}
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -514,14 +657,33 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("api_audit_log"); ok {
+		config.APIAuditLogPath = v.(string)
+	}
+
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		n := len(v.([]interface{}))
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				if n == 1 {
+					// A single empty block is ignored.
+					continue
+				}
+				// An empty block in a chain would change the position of every later role.
+				return nil, sdkdiag.AppendErrorf(diags, "assume_role %d of %d: IAM Role ARN not set", i+1, n)
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           i,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -532,10 +694,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("data_source_cache_ttl"); ok {
+		// Validated by the schema.
+		config.DataSourceCacheTTL, _ = time.ParseDuration(v.(string))
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		config.DefaultTimeoutsConfig = expandDefaultTimeouts(ctx, v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("deletion_guard"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionGuardConfig = expandDeletionGuard(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -563,14 +738,31 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("iam_preflight"); ok {
+		config.IAMPreflightMode = v.(string)
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("required_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.RequiredTagsConfig = expandRequiredTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retry"); ok && len(v.([]interface{})) > 0 {
+		serviceRetry, dx := expandServiceRetry(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetry = serviceRetry
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +797,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Roles are assumed in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -752,6 +944,90 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func defaultTimeoutsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with operation timeouts to default across resources of matching types.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default Create timeout, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"delete": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default Delete timeout, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"read": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default Read timeout, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"resource_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Resource type name, e.g. `aws_db_instance`, or glob pattern, e.g. `aws_db_*`.",
+					ValidateFunc: validResourceTypePattern,
+				},
+				"update": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The default Update timeout, e.g. `60m`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+			},
+		},
+	}
+}
+
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration block with a retry and rate limit policy for the API clients of a single service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times an API request to the service is attempted. Overrides `max_retries`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum time to wait between attempts, e.g. `30s`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum rate at which API requests, including retries, are sent to the service.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional API error codes that are retried.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service, as used in `endpoints`, e.g. `ec2`.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -849,9 +1125,86 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
 
+	if v, ok := tfMap["resource_type_tags"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			resourceTypeConfig := tftags.ResourceTypeDefaultConfig{}
+
+			if v, ok := tfMap["resource_type"].(string); ok {
+				resourceTypeConfig.ResourceType = v
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				resourceTypeConfig.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.ResourceTypeTags = append(defaultConfig.ResourceTypeTags, resourceTypeConfig)
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandDefaultTimeouts(_ context.Context, tfList []interface{}) conns.DefaultTimeoutsConfig {
+	var defaultTimeoutsConfig conns.DefaultTimeoutsConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		defaultTimeouts := conns.DefaultTimeouts{}
+
+		if v, ok := tfMap["resource_type"].(string); ok {
+			defaultTimeouts.ResourceType = v
+		}
+
+		// Durations are validated by the schema.
+		if v, ok := tfMap["create"].(string); ok && v != "" {
+			defaultTimeouts.Create, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["read"].(string); ok && v != "" {
+			defaultTimeouts.Read, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["update"].(string); ok && v != "" {
+			defaultTimeouts.Update, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["delete"].(string); ok && v != "" {
+			defaultTimeouts.Delete, _ = time.ParseDuration(v)
+		}
+
+		defaultTimeoutsConfig = append(defaultTimeoutsConfig, defaultTimeouts)
+	}
+
+	return defaultTimeoutsConfig
+}
+
+func expandDeletionGuard(_ context.Context, tfMap map[string]interface{}) *conns.DeletionGuardConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	deletionGuardConfig := &conns.DeletionGuardConfig{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+		deletionGuardConfig.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		deletionGuardConfig.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	return deletionGuardConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1223,103 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRequiredTags(_ context.Context, tfMap map[string]interface{}) *tftags.RequiredConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{
+		Mode: tftags.RequiredTagsModeWarn,
+		Tags: make(map[string][]*regexp.Regexp),
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		requiredConfig.Mode = v
+	}
+
+	if v, ok := tfMap["tag"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key, ok := tfMap["key"].(string)
+			if !ok || key == "" {
+				continue
+			}
+
+			var patterns []*regexp.Regexp
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				for _, v := range flex.ExpandStringValueSet(v) {
+					// Allowed values must match the whole tag value. Patterns are validated in the schema.
+					if re, err := regexp.Compile(`^(?:` + v + `)$`); err == nil {
+						patterns = append(patterns, re)
+					}
+				}
+			}
+
+			requiredConfig.Tags[key] = append(requiredConfig.Tags[key], patterns...)
+		}
+	}
+
+	return requiredConfig
+}
+
+func expandServiceRetry(_ context.Context, tfList []interface{}) (map[string]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRetryPath := cty.GetAttrPath("service_retry")
+	serviceRetry := make(map[string]conns.ServiceRetryConfig)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		elementPath := serviceRetryPath.IndexInt(i)
+
+		pkg, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		if _, ok := serviceRetry[pkg]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate %q block for service %q.", errs.PathString(serviceRetryPath), pkg),
+			))
+			continue
+		}
+
+		config := conns.ServiceRetryConfig{}
+
+		if v, ok := tfMap["max_attempts"].(int); ok {
+			config.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			// Validated by the schema.
+			config.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			config.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			config.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		serviceRetry[pkg] = config
+	}
+
+	return serviceRetry, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceRegionSchema returns the schema of the top-level `region` attribute injected into regional resources.
+func resourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// dataSourceRegionSchema returns the schema of the top-level `region` attribute injected into regional data sources.
+func dataSourceRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+		ValidateFunc: verify.ValidRegionName,
+	}
+}
+
+// injectRegionSchema adds the top-level `region` attribute to the resource's or data source's schema.
+// It returns false if the schema already defines its own `region` attribute.
+func injectRegionSchema(r *schema.Resource, f func() *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if schemaFunc := r.SchemaFunc; schemaFunc != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := schemaFunc()
+			s[names.AttrRegion] = f()
+			return s
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = f()
+	}
+
+	return true
+}
+
+// regionFromResourceData returns the per-resource AWS Region, or the provider's configured Region if none is set.
+// Resources created before the `region` attribute existed have no value in state and are in the provider's Region.
+func regionFromResourceData(d interface{ Get(string) any }, meta any) string {
+	if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+		return v
+	}
+
+	if v, ok := meta.(*conns.AWSClient); ok {
+		return v.Region
+	}
+
+	return ""
+}
+
+// setOverrideRegion records the per-resource AWS Region in Context so that API clients are configured for that Region.
+func setOverrideRegion(ctx context.Context, region string, meta any) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if v, ok := meta.(*conns.AWSClient); ok && region == v.Region {
+		region = ""
+	}
+
+	inContext.OverrideRegion = region
+}
+
+// regionInterceptor implements per-resource AWS Region for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		setOverrideRegion(ctx, regionFromResourceData(d, meta), meta)
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			// Existing state from before the `region` attribute was introduced is upgraded here.
+			if err := d.Set(names.AttrRegion, regionFromResourceData(d, meta)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setRegionInPlan is a CustomizeDiffFunc that defaults the `region` attribute to the provider's configured Region.
+// The attribute is ForceNew, so a change of Region forces resource replacement.
+// It must be the first CustomizeDiffFunc run so that any others use the planned Region.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if err := planRegion(d, meta); err != nil {
+		return err
+	}
+
+	setOverrideRegion(ctx, regionFromResourceData(d, meta), meta)
+
+	return nil
+}
+
+func planRegion(d *schema.ResourceDiff, meta any) error {
+	var region string
+
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		switch v := config.GetAttr(names.AttrRegion); {
+		case !v.IsKnown():
+			return nil
+		case !v.IsNull():
+			region = v.AsString()
+		}
+	}
+
+	if region == "" {
+		if v, ok := meta.(*conns.AWSClient); ok {
+			region = v.Region
+		}
+	}
+
+	if region == "" {
+		return nil
+	}
+
+	if d.Id() == "" {
+		return d.SetNew(names.AttrRegion, region)
+	}
+
+	// Existing state from before the `region` attribute was introduced is upgraded by the next refresh.
+	if o, _ := d.GetChange(names.AttrRegion); o.(string) == "" || o.(string) == region {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, region)
+}
+
+// importRegion is a StateContextFunc wrapper that handles an optional `@region` suffix on the import ID.
+func importRegion(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		id, region := conns.SplitImportIDRegion(d.Id())
+
+		if region != "" {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+		}
+
+		setOverrideRegion(ctx, regionFromResourceData(d, meta), meta)
+
+		return f(ctx, d, meta)
+	}
+}
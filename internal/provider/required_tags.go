@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// requiredTagsProviderServer wraps the Plugin SDK provider server and checks the planned `tags_all` of
+// transparently tagged resources against the provider's required tags configuration.
+// Plugin SDK CustomizeDiff functions cannot return warnings, so the check is made on the planned state
+// returned by the provider server.
+type requiredTagsProviderServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+	// tagged is the set of type names of resources that have opted in to transparent tagging.
+	tagged map[string]struct{}
+}
+
+func newRequiredTagsProviderServer(ctx context.Context, provider *schema.Provider) func() tfprotov5.ProviderServer {
+	tagged := make(map[string]struct{})
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Tags != nil {
+				tagged[v.TypeName] = struct{}{}
+			}
+		}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &requiredTagsProviderServer{
+			ProviderServer: provider.GRPCProvider(),
+			provider:       provider,
+			tagged:         tagged,
+		}
+	}
+}
+
+func (s *requiredTagsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if _, ok := s.tagged[request.TypeName]; !ok {
+		return response, err
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, err
+		}
+	}
+
+	if v := s.checkRequiredTags(ctx, request, response); v != nil {
+		response.Diagnostics = append(response.Diagnostics, v)
+	}
+
+	return response, err
+}
+
+// checkRequiredTags returns a diagnostic if the planned `tags_all` of a resource being created, or whose tags are changing,
+// does not comply with the provider's required tags configuration.
+// Resources whose tags are not yet known are not checked.
+func (s *requiredTagsProviderServer) checkRequiredTags(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) *tfprotov5.Diagnostic {
+	meta, ok := s.provider.Meta().(*conns.AWSClient)
+	if !ok || meta.RequiredTagsConfig == nil {
+		return nil
+	}
+
+	r, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return nil
+	}
+
+	typ := r.CoreConfigSchema().ImpliedType()
+
+	planned, ok := attributeFromDynamicValue(response.PlannedState, typ, names.AttrTagsAll)
+	// Destroy.
+	if !ok {
+		return nil
+	}
+
+	if prior, ok := attributeFromDynamicValue(request.PriorState, typ, names.AttrTagsAll); ok && planned.IsWhollyKnown() && prior.Equals(planned).True() {
+		return nil
+	}
+
+	var id string
+	if v, ok := attributeFromDynamicValue(request.PriorState, typ, names.AttrID); ok && v.IsKnown() && !v.IsNull() {
+		id = v.AsString()
+	}
+
+	var tags tftags.KeyValueTags
+	if planned.IsWhollyKnown() {
+		tags = tftags.New(ctx, ctyStringMap(planned))
+	} else {
+		// `tags_all` is computed if not all tags are known, or for some resources, on create.
+		// Use the merged resource and default tags if the resource tags are known.
+		v, ok := attributeFromDynamicValue(response.PlannedState, typ, names.AttrTags)
+		if !ok || !v.IsWhollyKnown() {
+			return nil
+		}
+
+		tags = meta.DefaultTagsConfig.MergeTags(tftags.New(ctx, ctyStringMap(v)), request.TypeName).IgnoreConfig(meta.IgnoreTagsConfig)
+	}
+
+	err := meta.RequiredTagsConfig.Validate(tags)
+	if err == nil {
+		return nil
+	}
+
+	severity := tfprotov5.DiagnosticSeverityWarning
+	if meta.RequiredTagsConfig.IsError() {
+		severity = tfprotov5.DiagnosticSeverityError
+	}
+
+	return &tfprotov5.Diagnostic{
+		Severity:  severity,
+		Summary:   tftags.RequiredTagsSummary,
+		Detail:    tftags.RequiredTagsDetail(request.TypeName, id, err),
+		Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+	}
+}
+
+// attributeFromDynamicValue returns the value of a top-level attribute from a resource's msgpack-encoded state.
+// ok is false if the state is null or cannot be decoded.
+func attributeFromDynamicValue(v *tfprotov5.DynamicValue, typ cty.Type, name string) (cty.Value, bool) {
+	if v == nil || len(v.MsgPack) == 0 {
+		return cty.NilVal, false
+	}
+
+	value, err := ctymsgpack.Unmarshal(v.MsgPack, typ)
+	if err != nil || value.IsNull() || !value.IsKnown() {
+		return cty.NilVal, false
+	}
+
+	if !value.Type().HasAttribute(name) {
+		return cty.NilVal, false
+	}
+
+	return value.GetAttr(name), true
+}
+
+// ctyStringMap returns the non-null elements of a known cty map of strings.
+func ctyStringMap(v cty.Value) map[string]string {
+	m := make(map[string]string)
+
+	if v.IsNull() {
+		return m
+	}
+
+	for k, v := range v.AsValueMap() {
+		if !v.IsNull() {
+			m[k] = v.AsString()
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRequiredTagsProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tagsVal := func(m map[string]string) cty.Value {
+		if m == nil {
+			return cty.NullVal(cty.Map(cty.String))
+		}
+
+		elems := make(map[string]cty.Value)
+		for k, v := range m {
+			elems[k] = cty.StringVal(v)
+		}
+
+		return cty.MapVal(elems)
+	}
+
+	testCases := map[string]struct {
+		defaultTags map[string]string
+		priorTags   map[string]string
+		tags        map[string]string
+		wantDetail  string
+	}{
+		"create compliant": {
+			tags: map[string]string{"CostCenter": "1234"},
+		},
+		"create compliant with default tags": {
+			defaultTags: map[string]string{"CostCenter": "1234"},
+			tags:        map[string]string{"Owner": "team-a"},
+		},
+		"create non-compliant": {
+			tags:       map[string]string{"Owner": "team-a"},
+			wantDetail: "this aws_test resource does not comply",
+		},
+		"update non-compliant": {
+			priorTags:  map[string]string{"CostCenter": "1234"},
+			tags:       map[string]string{"Owner": "team-a"},
+			wantDetail: "this aws_test resource (test-id) does not comply",
+		},
+		"update unchanged": {
+			priorTags: map[string]string{"Owner": "team-a"},
+			tags:      map[string]string{"Owner": "team-a"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			noop := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				return nil
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					names.AttrTagsAll: {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+				// As verify.SetTagsDiff, but `tags_all` is not known when creating.
+				CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+					if d.Id() == "" {
+						return d.SetNewComputed(names.AttrTagsAll)
+					}
+
+					return d.SetNew(names.AttrTagsAll, d.Get(names.AttrTags))
+				},
+				CreateWithoutTimeout: noop,
+				ReadWithoutTimeout:   noop,
+				UpdateWithoutTimeout: noop,
+				DeleteWithoutTimeout: noop,
+			}
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					"aws_test": r,
+				},
+			}
+			provider.SetMeta(&conns.AWSClient{
+				DefaultTagsConfig: &tftags.DefaultConfig{
+					Tags: tftags.New(ctx, testCase.defaultTags),
+				},
+				IgnoreTagsConfig: &tftags.IgnoreConfig{},
+				RequiredTagsConfig: &tftags.RequiredConfig{
+					Mode: tftags.RequiredTagsModeError,
+					Tags: map[string][]*regexp.Regexp{
+						"CostCenter": nil,
+					},
+				},
+			})
+			server := &requiredTagsProviderServer{
+				ProviderServer: provider.GRPCProvider(),
+				provider:       provider,
+				tagged:         map[string]struct{}{"aws_test": {}},
+			}
+
+			typ := r.CoreConfigSchema().ImpliedType()
+			config := cty.ObjectVal(map[string]cty.Value{
+				names.AttrID:      cty.NullVal(cty.String),
+				names.AttrTags:    tagsVal(testCase.tags),
+				names.AttrTagsAll: cty.NullVal(cty.Map(cty.String)),
+			})
+			prior := cty.NullVal(typ)
+			proposed := config
+			if testCase.priorTags != nil {
+				prior = cty.ObjectVal(map[string]cty.Value{
+					names.AttrID:      cty.StringVal("test-id"),
+					names.AttrTags:    tagsVal(testCase.priorTags),
+					names.AttrTagsAll: tagsVal(testCase.priorTags),
+				})
+				proposed = cty.ObjectVal(map[string]cty.Value{
+					names.AttrID:      cty.StringVal("test-id"),
+					names.AttrTags:    tagsVal(testCase.tags),
+					names.AttrTagsAll: tagsVal(testCase.priorTags),
+				})
+			}
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				PriorState:       dynamicValue(t, prior, typ),
+				ProposedNewState: dynamicValue(t, proposed, typ),
+				Config:           dynamicValue(t, config, typ),
+			})
+			if err != nil {
+				t.Fatalf("planning: %s", err)
+			}
+
+			var got []*tfprotov5.Diagnostic
+			for _, v := range response.Diagnostics {
+				if v.Summary == tftags.RequiredTagsSummary {
+					got = append(got, v)
+				}
+			}
+
+			if testCase.wantDetail == "" {
+				if len(got) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", got)
+				}
+
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("diagnostics = %v, want 1 required tags diagnostic", response.Diagnostics)
+			}
+			if got, want := got[0].Severity, tfprotov5.DiagnosticSeverityError; got != want {
+				t.Errorf("severity = %v, want %v", got, want)
+			}
+			if got, want := got[0].Attribute, tftypes.NewAttributePath().WithAttributeName(names.AttrTags); !got.Equal(want) {
+				t.Errorf("attribute = %v, want %v", got, want)
+			}
+			if !strings.Contains(got[0].Detail, testCase.wantDetail) {
+				t.Errorf("detail = %q, want to contain %q", got[0].Detail, testCase.wantDetail)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_acmpca_policy")
+// @IAMPolicyAttributes("policy")
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyPut,
@@ -59,8 +59,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_acmpca_permission",
 		},
 		{
-			Factory:  ResourcePolicy,
-			TypeName: "aws_acmpca_policy",
+			Factory:             ResourcePolicy,
+			TypeName:            "aws_acmpca_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
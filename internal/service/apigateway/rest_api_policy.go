@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_api_gateway_rest_api_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceRestAPIPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRestAPIPolicyPut,
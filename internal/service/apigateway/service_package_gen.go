@@ -157,10 +157,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
-			Factory:  ResourceRestAPIPolicy,
-			TypeName: "aws_api_gateway_rest_api_policy",
+			Factory:             ResourceRestAPIPolicy,
+			TypeName:            "aws_api_gateway_rest_api_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceStage,
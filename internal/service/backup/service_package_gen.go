@@ -103,8 +103,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_backup_vault_notifications",
 		},
 		{
-			Factory:  ResourceVaultPolicy,
-			TypeName: "aws_backup_vault_policy",
+			Factory:             ResourceVaultPolicy,
+			TypeName:            "aws_backup_vault_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
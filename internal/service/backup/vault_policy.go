@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_backup_vault_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceVaultPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultPolicyPut,
@@ -130,7 +130,7 @@ func (r *customModelResource) Schema(ctx context.Context, request resource.Schem
 				Computed:   true,
 			},
 			"role_arn": schema.StringAttribute{
-				CustomType: fwtypes.ARNType,
+				CustomType: fwtypes.ARNOf("iam", "role"),
 				Required:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -628,7 +628,7 @@ type customModelResourceModel struct {
 	JobName              types.String                                                          `tfsdk:"job_name"`
 	JobStatus            fwtypes.StringEnum[awstypes.ModelCustomizationJobStatus]              `tfsdk:"job_status"`
 	OutputDataConfig     fwtypes.ListNestedObjectValueOf[customModelOutputDataConfigModel]     `tfsdk:"output_data_config"`
-	RoleARN              fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	RoleARN              fwtypes.ServiceARN                                                    `tfsdk:"role_arn"`
 	Tags                 types.Map                                                             `tfsdk:"tags"`
 	TagsAll              types.Map                                                             `tfsdk:"tags_all"`
 	Timeouts             timeouts.Value                                                        `tfsdk:"timeouts"`
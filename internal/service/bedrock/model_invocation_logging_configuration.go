@@ -68,7 +68,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Schema(ctx context.Context
 								Optional: true,
 							},
 							"role_arn": schema.StringAttribute{
-								CustomType: fwtypes.ARNType,
+								CustomType: fwtypes.ARNOf("iam", "role"),
 								Optional:   true,
 							},
 						},
@@ -249,7 +249,7 @@ type loggingConfigModel struct {
 type cloudWatchConfigModel struct {
 	LargeDataDeliveryS3Config fwtypes.ObjectValueOf[s3ConfigModel] `tfsdk:"large_data_delivery_s3_config"`
 	LogGroupName              types.String                         `tfsdk:"log_group_name"`
-	RoleArn                   fwtypes.ARN                          `tfsdk:"role_arn"`
+	RoleArn                   fwtypes.ServiceARN                   `tfsdk:"role_arn"`
 }
 
 type s3ConfigModel struct {
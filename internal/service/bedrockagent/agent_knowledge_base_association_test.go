@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentAgentKnowledgeBaseAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_knowledge_base_association.test"
+	var v awstypes.AgentKnowledgeBase
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, collectionName, "test desc", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test desc"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_id", "aws_bedrockagent_knowledge_base.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prepare_agent"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentKnowledgeBaseAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_knowledge_base_association.test"
+	var v awstypes.AgentKnowledgeBase
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, collectionName, "test desc", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceAgentKnowledgeBaseAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentKnowledgeBaseAssociation_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	collectionName := acctest.SkipIfEnvVarNotSet(t, envVarKnowledgeBaseCollectionName)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_knowledge_base_association.test"
+	var v awstypes.AgentKnowledgeBase
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, collectionName, "test desc", "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test desc"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccAgentKnowledgeBaseAssociationConfig_basic(rName, collectionName, "test desc updated", "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentKnowledgeBaseAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "test desc updated"),
+					resource.TestCheckResourceAttr(resourceName, "knowledge_base_state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentKnowledgeBaseAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent_knowledge_base_association" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindAgentKnowledgeBaseByThreePartKey(ctx, conn, rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"], rs.Primary.Attributes["knowledge_base_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Knowledge Base Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentKnowledgeBaseAssociationExists(ctx context.Context, n string, v *awstypes.AgentKnowledgeBase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindAgentKnowledgeBaseByThreePartKey(ctx, conn, rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"], rs.Primary.Attributes["knowledge_base_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAgentKnowledgeBaseAssociationConfig_basic(rName, collectionName, description, state string) string {
+	return acctest.ConfigCompose(testAccKnowledgeBaseConfig_basic(rName, collectionName), fmt.Sprintf(`
+data "aws_bedrock_foundation_model" "test" {
+  model_id = "anthropic.claude-v2"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "bedrock.amazonaws.com"
+    },
+    "Action": "sts:AssumeRole",
+    "Condition": {
+      "StringEquals": {
+        "aws:SourceAccount": "${data.aws_caller_identity.current.account_id}"
+      },
+      "ArnLike": {
+        "aws:SourceArn": "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:agent/*"
+      }
+    }
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "bedrock:InvokeModel",
+    "Resource": "${data.aws_bedrock_foundation_model.test.model_arn}"
+  }, {
+    "Effect": "Allow",
+    "Action": "bedrock:Retrieve",
+    "Resource": "${aws_bedrockagent_knowledge_base.test.arn}"
+  }]
+}
+EOF
+}
+
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test.arn
+  instruction             = "basic claude agent instruction for testing purposes"
+  foundation_model        = data.aws_bedrock_foundation_model.test.model_id
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_bedrockagent_agent_knowledge_base_association" "test" {
+  agent_id             = aws_bedrockagent_agent.test.agent_id
+  agent_version        = "DRAFT"
+  description          = %[2]q
+  knowledge_base_id    = aws_bedrockagent_knowledge_base.test.id
+  knowledge_base_state = %[3]q
+}
+`, rName, description, state))
+}
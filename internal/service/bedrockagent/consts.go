@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
+const (
+	// testAgentAliasID is the ID of the alias that every agent has for testing its DRAFT version.
+	testAgentAliasID = "TSTALIASID"
+)
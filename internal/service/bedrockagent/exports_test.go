@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+// Exports for use in tests only.
+var (
+	ResourceAgent                         = newAgentResource
+	ResourceAgentActionGroup              = newAgentActionGroupResource
+	ResourceAgentAlias                    = newAgentAliasResource
+	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
+	ResourceDataSource                    = newDataSourceResource
+	ResourceKnowledgeBase                 = newKnowledgeBaseResource
+
+	FindAgentActionGroupByThreePartKey   = findAgentActionGroupByThreePartKey
+	FindAgentAliasByTwoPartKey           = findAgentAliasByTwoPartKey
+	FindAgentByID                        = findAgentByID
+	FindAgentKnowledgeBaseByThreePartKey = findAgentKnowledgeBaseByThreePartKey
+	FindDataSourceByTwoPartKey           = findDataSourceByTwoPartKey
+	FindKnowledgeBaseByID                = findKnowledgeBaseByID
+)
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_bedrockagent_agent", sweepAgents,
+		"aws_bedrockagent_agent_alias",
+	)
+
+	sweep.Register("aws_bedrockagent_agent_alias", sweepAgentAliases)
+
+	sweep.Register("aws_bedrockagent_data_source", sweepDataSources)
+
+	sweep.Register("aws_bedrockagent_knowledge_base", sweepKnowledgeBases,
+		"aws_bedrockagent_agent",
+		"aws_bedrockagent_data_source",
+	)
+}
+
+func sweepAgents(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentClient(ctx)
+	input := &bedrockagent.ListAgentsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagent.NewListAgentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sweepResources, err
+		}
+
+		for _, v := range page.AgentSummaries {
+			id := aws.ToString(v.AgentId)
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAgentResource, client,
+				framework.NewAttribute("id", id),
+				framework.NewAttribute("agent_id", id),
+				framework.NewAttribute("skip_resource_in_use_check", true),
+			).WithImportID(id))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepAgentAliases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentClient(ctx)
+	input := &bedrockagent.ListAgentsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagent.NewListAgentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sweepResources, err
+		}
+
+		for _, v := range page.AgentSummaries {
+			agentID := aws.ToString(v.AgentId)
+			input := &bedrockagent.ListAgentAliasesInput{
+				AgentId: aws.String(agentID),
+			}
+
+			pages := bedrockagent.NewListAgentAliasesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return sweepResources, err
+				}
+
+				for _, v := range page.AgentAliasSummaries {
+					agentAliasID := aws.ToString(v.AgentAliasId)
+
+					// The agent's test alias can't be deleted.
+					if agentAliasID == testAgentAliasID {
+						continue
+					}
+
+					id := errs.Must(flex.FlattenResourceId([]string{agentAliasID, agentID}, agentAliasResourceIDPartCount, false))
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newAgentAliasResource, client,
+						framework.NewAttribute("id", id),
+						framework.NewAttribute("agent_alias_id", agentAliasID),
+						framework.NewAttribute("agent_id", agentID),
+					).WithImportID(id))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepKnowledgeBases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentClient(ctx)
+	input := &bedrockagent.ListKnowledgeBasesInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagent.NewListKnowledgeBasesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sweepResources, err
+		}
+
+		for _, v := range page.KnowledgeBaseSummaries {
+			id := aws.ToString(v.KnowledgeBaseId)
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newKnowledgeBaseResource, client,
+				framework.NewAttribute("id", id),
+			).WithImportID(id))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.BedrockAgentClient(ctx)
+	input := &bedrockagent.ListKnowledgeBasesInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := bedrockagent.NewListKnowledgeBasesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sweepResources, err
+		}
+
+		for _, v := range page.KnowledgeBaseSummaries {
+			knowledgeBaseID := aws.ToString(v.KnowledgeBaseId)
+			input := &bedrockagent.ListDataSourcesInput{
+				KnowledgeBaseId: aws.String(knowledgeBaseID),
+			}
+
+			pages := bedrockagent.NewListDataSourcesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return sweepResources, err
+				}
+
+				for _, v := range page.DataSourceSummaries {
+					dataSourceID := aws.ToString(v.DataSourceId)
+					id := errs.Must(flex.FlattenResourceId([]string{dataSourceID, knowledgeBaseID}, dataSourceResourceIDPartCount, false))
+
+					sweepResources = append(sweepResources, framework.NewSweepResource(newDataSourceResource, client,
+						framework.NewAttribute("id", id),
+						framework.NewAttribute("data_source_id", dataSourceID),
+						framework.NewAttribute("knowledge_base_id", knowledgeBaseID),
+					).WithImportID(id))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudsearch_domain_service_access_policy", name="Domain Service Access Policy")
+// @IAMPolicyAttributes("access_policy")
 func resourceDomainServiceAccessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainServiceAccessPolicyPut,
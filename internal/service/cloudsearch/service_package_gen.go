@@ -34,9 +34,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Domain",
 		},
 		{
-			Factory:  resourceDomainServiceAccessPolicy,
-			TypeName: "aws_cloudsearch_domain_service_access_policy",
-			Name:     "Domain Service Access Policy",
+			Factory:             resourceDomainServiceAccessPolicy,
+			TypeName:            "aws_cloudsearch_domain_service_access_policy",
+			Name:                "Domain Service Access Policy",
+			IAMPolicyAttributes: []string{"access_policy"},
 		},
 	}
 }
@@ -48,9 +48,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  resourceDomainPermissionsPolicy,
-			TypeName: "aws_codeartifact_domain_permissions_policy",
-			Name:     "Domain Permissions Policy",
+			Factory:             resourceDomainPermissionsPolicy,
+			TypeName:            "aws_codeartifact_domain_permissions_policy",
+			Name:                "Domain Permissions Policy",
+			IAMPolicyAttributes: []string{"policy_document"},
 		},
 		{
 			Factory:  resourceRepository,
@@ -61,9 +62,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  resourceRepositoryPermissionsPolicy,
-			TypeName: "aws_codeartifact_repository_permissions_policy",
-			Name:     "Repository Permissions Policy",
+			Factory:             resourceRepositoryPermissionsPolicy,
+			TypeName:            "aws_codeartifact_repository_permissions_policy",
+			Name:                "Repository Permissions Policy",
+			IAMPolicyAttributes: []string{"policy_document"},
 		},
 	}
 }
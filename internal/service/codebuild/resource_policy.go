@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_codebuild_resource_policy", name="Resource Policy")
+// @IAMPolicyAttributes("policy")
 func resourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut,
@@ -41,9 +41,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
-			Factory:  resourceResourcePolicy,
-			TypeName: "aws_codebuild_resource_policy",
-			Name:     "Resource Policy",
+			Factory:             resourceResourcePolicy,
+			TypeName:            "aws_codebuild_resource_policy",
+			Name:                "Resource Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceSourceCredential,
@@ -6,8 +6,11 @@ package dynamodb
 // Exports for use in tests only.
 var (
 	ResourceKinesisStreamingDestination = resourceKinesisStreamingDestination
+	ResourceResourcePolicy              = resourceResourcePolicy
 	ResourceTag                         = resourceTag
 
 	FindKinesisDataStreamDestinationByTwoPartKey = findKinesisDataStreamDestinationByTwoPartKey
+	FindResourcePolicyByARN                      = findResourcePolicyByARN
+	FindTableImportByARN                         = findTableImportByARN
 	ListTags                                     = listTags
 )
@@ -31,6 +31,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTable,
 			TypeName: "aws_dynamodb_table",
 		},
+		{
+			Factory:  dataSourceTableImport,
+			TypeName: "aws_dynamodb_table_import",
+			Name:     "Table Import",
+		},
 		{
 			Factory:  DataSourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
@@ -53,6 +58,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 			Name:     "Kinesis Streaming Destination",
 		},
+		{
+			Factory:             resourceResourcePolicy,
+			TypeName:            "aws_dynamodb_resource_policy",
+			Name:                "Resource Policy",
+			IAMPolicyAttributes: []string{"policy"},
+		},
 		{
 			Factory:  ResourceTable,
 			TypeName: "aws_dynamodb_table",
@@ -65,6 +76,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTableExport,
 			TypeName: "aws_dynamodb_table_export",
 		},
+		{
+			Factory:  resourceTableImport,
+			TypeName: "aws_dynamodb_table_import",
+			Name:     "Table Import",
+		},
 		{
 			Factory:  ResourceTableItem,
 			TypeName: "aws_dynamodb_table_item",
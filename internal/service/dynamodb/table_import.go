@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dynamodb_table_import", name="Table Import")
+func resourceTableImport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableImportCreate,
+		ReadWithoutTimeout:   resourceTableImportRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_log_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+			},
+			"input_format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+			},
+			"input_format_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delimiter": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"header_list": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processed_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processed_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket_owner": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_creation_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dynamodb.ScalarAttributeType_Values(), false),
+									},
+								},
+							},
+						},
+						"billing_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      dynamodb.BillingModeProvisioned,
+							ValidateFunc: validation.StringInSlice(dynamodb.BillingMode_Values(), false),
+						},
+						"hash_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"range_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"read_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"write_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTableImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	input := expandImportTable(map[string]interface{}{
+		"input_compression_type": d.Get("input_compression_type"),
+		"input_format":           d.Get("input_format"),
+		"input_format_options":   d.Get("input_format_options"),
+		"s3_bucket_source":       d.Get("s3_bucket_source"),
+	})
+
+	// An empty compression type isn't accepted by the API.
+	if aws.StringValue(input.InputCompressionType) == "" {
+		input.InputCompressionType = nil
+	}
+
+	if v, ok := d.GetOk("table_creation_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TableCreationParameters = expandTableCreationParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	tableName := aws.StringValue(input.TableCreationParameters.TableName)
+	output, err := conn.ImportTableWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table Import (%s): %s", tableName, err)
+	}
+
+	d.SetId(aws.StringValue(output.ImportTableDescription.ImportArn))
+
+	if _, err := waitImportComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Import (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTableImportRead(ctx, d, meta)...)
+}
+
+func resourceTableImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	desc, err := findTableImportByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Import (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Import (%s): %s", d.Id(), err)
+	}
+
+	if err := setTableImportDescription(d, desc); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func setTableImportDescription(d *schema.ResourceData, desc *dynamodb.ImportTableDescription) error {
+	d.Set(names.AttrARN, desc.ImportArn)
+	d.Set("cloudwatch_log_group_arn", desc.CloudWatchLogGroupArn)
+	if desc.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(desc.EndTime).Format(time.RFC3339))
+	}
+	d.Set("error_count", desc.ErrorCount)
+	d.Set("failure_code", desc.FailureCode)
+	d.Set("failure_message", desc.FailureMessage)
+	d.Set("import_status", desc.ImportStatus)
+	d.Set("imported_item_count", desc.ImportedItemCount)
+	d.Set("input_compression_type", desc.InputCompressionType)
+	d.Set("input_format", desc.InputFormat)
+	if err := d.Set("input_format_options", flattenInputFormatOptions(desc.InputFormatOptions)); err != nil {
+		return fmt.Errorf("setting input_format_options: %w", err)
+	}
+	d.Set("processed_item_count", desc.ProcessedItemCount)
+	d.Set("processed_size_bytes", desc.ProcessedSizeBytes)
+	if err := d.Set("s3_bucket_source", flattenS3BucketSource(desc.S3BucketSource)); err != nil {
+		return fmt.Errorf("setting s3_bucket_source: %w", err)
+	}
+	if desc.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(desc.StartTime).Format(time.RFC3339))
+	}
+	d.Set("table_arn", desc.TableArn)
+	if err := d.Set("table_creation_parameters", flattenTableCreationParameters(desc.TableCreationParameters)); err != nil {
+		return fmt.Errorf("setting table_creation_parameters: %w", err)
+	}
+	d.Set("table_id", desc.TableId)
+
+	return nil
+}
+
+func expandTableCreationParameters(tfMap map[string]interface{}) *dynamodb.TableCreationParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	billingMode := tfMap["billing_mode"].(string)
+	apiObject := &dynamodb.TableCreationParameters{
+		BillingMode:           aws.String(billingMode),
+		KeySchema:             expandKeySchema(tfMap),
+		ProvisionedThroughput: expandProvisionedThroughput(tfMap, billingMode),
+		TableName:             aws.String(tfMap["table_name"].(string)),
+	}
+
+	if v, ok := tfMap["attribute"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AttributeDefinitions = expandAttributes(v.List())
+	}
+
+	return apiObject
+}
+
+func flattenTableCreationParameters(apiObject *dynamodb.TableCreationParameters) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"attribute":    flattenTableAttributeDefinitions(apiObject.AttributeDefinitions),
+		"billing_mode": aws.StringValue(apiObject.BillingMode),
+		"table_name":   aws.StringValue(apiObject.TableName),
+	}
+
+	for _, v := range apiObject.KeySchema {
+		switch aws.StringValue(v.KeyType) {
+		case dynamodb.KeyTypeHash:
+			tfMap["hash_key"] = aws.StringValue(v.AttributeName)
+		case dynamodb.KeyTypeRange:
+			tfMap["range_key"] = aws.StringValue(v.AttributeName)
+		}
+	}
+
+	if v := apiObject.ProvisionedThroughput; v != nil {
+		tfMap["read_capacity"] = aws.Int64Value(v.ReadCapacityUnits)
+		tfMap["write_capacity"] = aws.Int64Value(v.WriteCapacityUnits)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputFormatOptions(apiObject *dynamodb.InputFormatOptions) []interface{} {
+	if apiObject == nil || apiObject.Csv == nil {
+		return []interface{}{}
+	}
+
+	csv := map[string]interface{}{
+		"delimiter":   aws.StringValue(apiObject.Csv.Delimiter),
+		"header_list": aws.StringValueSlice(apiObject.Csv.HeaderList),
+	}
+
+	return []interface{}{map[string]interface{}{
+		"csv": []interface{}{csv},
+	}}
+}
+
+func flattenS3BucketSource(apiObject *dynamodb.S3BucketSource) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket":       aws.StringValue(apiObject.S3Bucket),
+		"bucket_owner": aws.StringValue(apiObject.S3BucketOwner),
+		"key_prefix":   aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
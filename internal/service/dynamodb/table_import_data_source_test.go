@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableImportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_import.test"
+	dataSourceName := "data.aws_dynamodb_table_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "error_count", resourceName, "error_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "import_status", resourceName, "import_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "imported_item_count", resourceName, "imported_item_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "input_format", resourceName, "input_format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "processed_item_count", resourceName, "processed_item_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_bucket_source.#", resourceName, "s3_bucket_source.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_arn", resourceName, "table_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_creation_parameters.#", resourceName, "table_creation_parameters.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_id", resourceName, "table_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableImportDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableImportConfig_basic(rName), `
+data "aws_dynamodb_table_import" "test" {
+  import_arn = aws_dynamodb_table_import.test.arn
+}
+`)
+}
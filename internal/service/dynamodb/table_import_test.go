@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v dynamodb.ImportTableDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_import.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableImportExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexache.MustCompile(
+						fmt.Sprintf("table\\/%s\\/import\\/+.", rName),
+					)),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttr(resourceName, "error_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "import_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "imported_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "processed_item_count", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "processed_size_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_source.0.bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_bucket_source.0.key_prefix", "import"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "table_arn", "dynamodb", fmt.Sprintf("table/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.billing_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "table_creation_parameters.0.table_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "table_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableImportExists(ctx context.Context, n string, v *dynamodb.ImportTableDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		output, err := tfdynamodb.FindTableImportByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableImportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = <<EOT
+id,name
+1,one
+2,two
+EOT
+}
+`, rName)
+}
+
+func testAccTableImportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableImportConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_import" "test" {
+  input_format = "CSV"
+
+  input_format_options {
+    csv {
+      delimiter = ","
+    }
+  }
+
+  s3_bucket_source {
+    bucket     = aws_s3_bucket.test.id
+    key_prefix = "import"
+  }
+
+  table_creation_parameters {
+    table_name   = %[1]q
+    billing_mode = "PAY_PER_REQUEST"
+    hash_key     = "id"
+
+    attribute {
+      name = "id"
+      type = "S"
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName))
+}
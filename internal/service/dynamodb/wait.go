@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -62,15 +64,19 @@ func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, importArn
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if err != nil {
-		err = fmt.Errorf("ImportArn %q : %w", importArn, err)
+	output, _ := outputRaw.(*dynamodb.DescribeImportOutput)
+
+	if output != nil {
+		if desc := output.ImportTableDescription; desc != nil && aws.StringValue(desc.ImportStatus) == dynamodb.ImportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(desc.FailureCode), aws.StringValue(desc.FailureMessage)))
+		}
 	}
 
-	if output, ok := outputRaw.(*dynamodb.DescribeImportOutput); ok {
-		return output, err
+	if err != nil {
+		err = fmt.Errorf("ImportArn %q : %w", importArn, err)
 	}
 
-	return nil, err
+	return output, err
 }
 
 func waitTableDeleted(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) (*dynamodb.TableDescription, error) {
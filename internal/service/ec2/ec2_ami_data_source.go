@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 )
 
 // @SDKDataSource("aws_ami")
+// @Cacheable
 func DataSourceAMI() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAMIRead,
@@ -251,7 +253,9 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.Owners = flex.ExpandStringList(v.([]interface{}))
 	}
 
-	images, err := FindImages(ctx, conn, input)
+	images, err := conns.CachedRead(ctx, meta.(*conns.AWSClient), "DescribeImages", input, func(ctx context.Context) ([]*ec2.Image, error) {
+		return FindImages(ctx, conn, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
@@ -275,7 +279,8 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 			}
 		}
 	} else {
-		filteredImages = images[:]
+		// Copy as the images may be shared with other reads via the data source cache.
+		filteredImages = slices.Clone(images)
 	}
 
 	if len(filteredImages) < 1 {
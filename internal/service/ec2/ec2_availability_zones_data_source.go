@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_availability_zones")
+// @Cacheable
 func DataSourceAvailabilityZones() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAvailabilityZonesRead,
@@ -100,15 +101,22 @@ func dataSourceAvailabilityZonesRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	log.Printf("[DEBUG] Reading Availability Zones: %s", request)
-	resp, err := conn.DescribeAvailabilityZonesWithContext(ctx, request)
+	resp, err := conns.CachedRead(ctx, meta.(*conns.AWSClient), "DescribeAvailabilityZones", request, func(ctx context.Context) (*ec2.DescribeAvailabilityZonesOutput, error) {
+		output, err := conn.DescribeAvailabilityZonesWithContext(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Slice(output.AvailabilityZones, func(i, j int) bool {
+			return aws.StringValue(output.AvailabilityZones[i].ZoneName) < aws.StringValue(output.AvailabilityZones[j].ZoneName)
+		})
+
+		return output, nil
+	})
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "fetching Availability Zones: %s", err)
 	}
 
-	sort.Slice(resp.AvailabilityZones, func(i, j int) bool {
-		return aws.StringValue(resp.AvailabilityZones[i].ZoneName) < aws.StringValue(resp.AvailabilityZones[j].ZoneName)
-	})
-
 	excludeNames := d.Get("exclude_names").(*schema.Set)
 	excludeZoneIDs := d.Get("exclude_zone_ids").(*schema.Set)
 
@@ -937,7 +937,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tagSpecifications = append(tagSpecifications,
 		tagSpecificationsFromKeyValue(
-			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))),
+			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{})), "aws_instance"),
 			ec2.ResourceTypeVolume)...)
 
 	input := &ec2.RunInstancesInput{
@@ -1296,7 +1296,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 		tags := KeyValueTags(ctx, volumeTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
-		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig, "aws_instance").Map()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting volume_tags: %s", err)
 		}
 	}
@@ -2324,7 +2324,7 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 				bd[names.AttrTags] = KeyValueTags(ctx, vol.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()
 			} else {
 				tags := KeyValueTags(ctx, vol.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
-				bd[names.AttrTags] = tags.RemoveDefaultConfig(defaultTagsConfig).Map()
+				bd[names.AttrTags] = tags.RemoveDefaultConfig(defaultTagsConfig, "aws_instance").Map()
 				bd[names.AttrTagsAll] = tags.Map()
 			}
 		}
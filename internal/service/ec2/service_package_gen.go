@@ -61,8 +61,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  DataSourceAMI,
-			TypeName: "aws_ami",
+			Factory:   DataSourceAMI,
+			TypeName:  "aws_ami",
+			Cacheable: true,
 		},
 		{
 			Factory:  DataSourceAMIIDs,
@@ -73,8 +74,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_availability_zone",
 		},
 		{
-			Factory:  DataSourceAvailabilityZones,
-			TypeName: "aws_availability_zones",
+			Factory:   DataSourceAvailabilityZones,
+			TypeName:  "aws_availability_zones",
+			Cacheable: true,
 		},
 		{
 			Factory:  dataSourceCustomerGateway,
@@ -1016,6 +1018,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"ec2:CreateVpc", "ec2:CreateTags", "ec2:DescribeVpcs", "ec2:DescribeVpcAttribute", "ec2:ModifyVpcAttribute"},
+				Update: []string{"ec2:ModifyVpcAttribute", "ec2:ModifyVpcTenancy", "ec2:AssociateVpcCidrBlock", "ec2:DisassociateVpcCidrBlock", "ec2:CreateTags", "ec2:DeleteTags"},
+				Delete: []string{"ec2:DeleteVpc", "ec2:DescribeVpcs"},
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
@@ -1036,6 +1043,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceVPCEndpointConnectionAccepter,
@@ -1047,8 +1055,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "VPC Endpoint Connection Notification",
 		},
 		{
-			Factory:  ResourceVPCEndpointPolicy,
-			TypeName: "aws_vpc_endpoint_policy",
+			Factory:             ResourceVPCEndpointPolicy,
+			TypeName:            "aws_vpc_endpoint_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceVPCEndpointRouteTableAssociation,
@@ -39,6 +39,7 @@ const (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @IAMActions(create="ec2:CreateVpc;ec2:CreateTags;ec2:DescribeVpcs;ec2:DescribeVpcAttribute;ec2:ModifyVpcAttribute", update="ec2:ModifyVpcAttribute;ec2:ModifyVpcTenancy;ec2:AssociateVpcCidrBlock;ec2:DisassociateVpcCidrBlock;ec2:CreateTags;ec2:DeleteTags", delete="ec2:DeleteVpc;ec2:DescribeVpcs")
 func ResourceVPC() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_vpc_endpoint_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceVPCEndpointPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCEndpointPolicyPut,
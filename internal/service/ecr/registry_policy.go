@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_ecr_registry_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceRegistryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegistryPolicyPut,
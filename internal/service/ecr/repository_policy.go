@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_ecr_repository_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceRepositoryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryPolicyPut,
@@ -64,8 +64,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Pull Through Cache Rule",
 		},
 		{
-			Factory:  ResourceRegistryPolicy,
-			TypeName: "aws_ecr_registry_policy",
+			Factory:             ResourceRegistryPolicy,
+			TypeName:            "aws_ecr_registry_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceRegistryScanningConfiguration,
@@ -84,8 +85,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceRepositoryPolicy,
-			TypeName: "aws_ecr_repository_policy",
+			Factory:             ResourceRepositoryPolicy,
+			TypeName:            "aws_ecr_repository_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ecrpublic_repository_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceRepositoryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryPolicyPut,
@@ -42,8 +42,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceRepositoryPolicy,
-			TypeName: "aws_ecrpublic_repository_policy",
+			Factory:             ResourceRepositoryPolicy,
+			TypeName:            "aws_ecrpublic_repository_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_efs_file_system_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceFileSystemPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemPolicyPut,
@@ -67,8 +67,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceFileSystemPolicy,
-			TypeName: "aws_efs_file_system_policy",
+			Factory:             ResourceFileSystemPolicy,
+			TypeName:            "aws_efs_file_system_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceMountTarget,
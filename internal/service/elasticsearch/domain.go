@@ -31,6 +31,7 @@ import (
 
 // @SDKResource("aws_elasticsearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("access_policies")
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
@@ -41,10 +41,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"access_policies"},
 		},
 		{
-			Factory:  ResourceDomainPolicy,
-			TypeName: "aws_elasticsearch_domain_policy",
+			Factory:             ResourceDomainPolicy,
+			TypeName:            "aws_elasticsearch_domain_policy",
+			IAMPolicyAttributes: []string{"access_policies"},
 		},
 		{
 			Factory:  ResourceDomainSAMLOptions,
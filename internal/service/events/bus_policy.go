@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_event_bus_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceBusPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBusPolicyPut,
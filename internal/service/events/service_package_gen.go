@@ -59,8 +59,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceBusPolicy,
-			TypeName: "aws_cloudwatch_event_bus_policy",
+			Factory:             ResourceBusPolicy,
+			TypeName:            "aws_cloudwatch_event_bus_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceConnection,
@@ -467,7 +467,7 @@ func flattenDataRepositoryAssociations(ctx context.Context, dataRepositoryAssoci
 			"imported_file_chunk_size":       dataRepositoryAssociation.ImportedFileChunkSize,
 			"nfs":                            flattenNFSDataRepositoryConfiguration(dataRepositoryAssociation.NFS),
 			"resource_arn":                   dataRepositoryAssociation.ResourceARN,
-			"tags":                           tags.RemoveDefaultConfig(defaultTagsConfig).Map(),
+			"tags":                           tags.RemoveDefaultConfig(defaultTagsConfig, "aws_fsx_file_cache").Map(),
 		}
 		flattenedDataRepositoryAssociations = append(flattenedDataRepositoryAssociations, values)
 	}
@@ -35,10 +35,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"access_policy"},
 		},
 		{
-			Factory:  resourceVaultLock,
-			TypeName: "aws_glacier_vault_lock",
+			Factory:             resourceVaultLock,
+			TypeName:            "aws_glacier_vault_lock",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_glacier_vault", name="Vault")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("access_policy")
 func resourceVault() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultCreate,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_glacier_vault_lock")
+// @IAMPolicyAttributes("policy")
 func resourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_glue_resource_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut(glue.ExistConditionNotExist),
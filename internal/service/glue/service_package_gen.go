@@ -131,8 +131,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceResourcePolicy,
-			TypeName: "aws_glue_resource_policy",
+			Factory:             ResourceResourcePolicy,
+			TypeName:            "aws_glue_resource_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceSchema,
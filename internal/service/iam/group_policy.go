@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy", name="Group Policy")
+// @IAMPolicyAttributes("policy")
 func resourceGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyPut,
@@ -41,6 +41,8 @@ const (
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="id", resourceType="Role")
 // @Testing(existsType="github.com/aws/aws-sdk-go/service/iam.Role")
+// @IAMActions(create="iam:CreateRole;iam:GetRole;iam:PutRolePolicy;iam:AttachRolePolicy", update="iam:UpdateAssumeRolePolicy;iam:UpdateRole;iam:UpdateRoleDescription;iam:PutRolePermissionsBoundary;iam:DeleteRolePermissionsBoundary;iam:TagRole;iam:UntagRole", delete="iam:DeleteRole;iam:ListInstanceProfilesForRole;iam:RemoveRoleFromInstanceProfile;iam:ListAttachedRolePolicies;iam:DetachRolePolicy;iam:ListRolePolicies;iam:DeleteRolePolicy")
+// @IAMPolicyAttributes("assume_role_policy")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoleCreate,
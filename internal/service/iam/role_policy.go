@@ -30,6 +30,7 @@ const (
 )
 
 // @SDKResource("aws_iam_role_policy", name="Role Policy")
+// @IAMPolicyAttributes("policy")
 func resourceRolePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyPut,
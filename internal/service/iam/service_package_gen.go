@@ -141,9 +141,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Group Membership",
 		},
 		{
-			Factory:  resourceGroupPolicy,
-			TypeName: "aws_iam_group_policy",
-			Name:     "Group Policy",
+			Factory:             resourceGroupPolicy,
+			TypeName:            "aws_iam_group_policy",
+			Name:                "Group Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceGroupPolicyAttachment,
@@ -176,6 +177,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 				ResourceType:        "Policy",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourcePolicyAttachment,
@@ -190,11 +192,18 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 				ResourceType:        "Role",
 			},
+			IAMActions: &types.ServicePackageResourceIAMActions{
+				Create: []string{"iam:CreateRole", "iam:GetRole", "iam:PutRolePolicy", "iam:AttachRolePolicy"},
+				Update: []string{"iam:UpdateAssumeRolePolicy", "iam:UpdateRole", "iam:UpdateRoleDescription", "iam:PutRolePermissionsBoundary", "iam:DeleteRolePermissionsBoundary", "iam:TagRole", "iam:UntagRole"},
+				Delete: []string{"iam:DeleteRole", "iam:ListInstanceProfilesForRole", "iam:RemoveRoleFromInstanceProfile", "iam:ListAttachedRolePolicies", "iam:DetachRolePolicy", "iam:ListRolePolicies", "iam:DeleteRolePolicy"},
+			},
+			IAMPolicyAttributes: []string{"assume_role_policy"},
 		},
 		{
-			Factory:  resourceRolePolicy,
-			TypeName: "aws_iam_role_policy",
-			Name:     "Role Policy",
+			Factory:             resourceRolePolicy,
+			TypeName:            "aws_iam_role_policy",
+			Name:                "Role Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceRolePolicyAttachment,
@@ -263,9 +272,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "User Login Profile",
 		},
 		{
-			Factory:  resourceUserPolicy,
-			TypeName: "aws_iam_user_policy",
-			Name:     "User Policy",
+			Factory:             resourceUserPolicy,
+			TypeName:            "aws_iam_user_policy",
+			Name:                "User Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceUserPolicyAttachment,
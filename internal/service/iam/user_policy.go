@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy", name="User Policy")
+// @IAMPolicyAttributes("policy")
 func resourceUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyPut,
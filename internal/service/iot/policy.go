@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_iot_policy")
 // @Tags(identifierAttribute="arn")
+// @IAMPolicyAttributes("policy")
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
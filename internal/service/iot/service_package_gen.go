@@ -91,6 +91,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourcePolicyAttachment,
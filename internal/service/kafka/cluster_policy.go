@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_msk_cluster_policy", name="Cluster Policy")
+// @IAMPolicyAttributes("policy")
 func resourceClusterPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterPolicyPut,
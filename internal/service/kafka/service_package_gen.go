@@ -67,9 +67,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  resourceClusterPolicy,
-			TypeName: "aws_msk_cluster_policy",
-			Name:     "Cluster Policy",
+			Factory:             resourceClusterPolicy,
+			TypeName:            "aws_msk_cluster_policy",
+			Name:                "Cluster Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceConfiguration,
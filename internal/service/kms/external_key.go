@@ -33,6 +33,7 @@ import (
 
 // @SDKResource("aws_kms_external_key", name="External Key")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("policy")
 func ResourceExternalKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceExternalKeyCreate,
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKResource("aws_kms_key_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceKeyPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyPolicyCreate,
@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_kms_replica_external_key", name="Replica External Key")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("policy")
 func ResourceReplicaExternalKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicaExternalKeyCreate,
@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_kms_replica_key", name="Replica Key")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("policy")
 func ResourceReplicaKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReplicaKeyCreate,
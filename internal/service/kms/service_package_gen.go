@@ -77,6 +77,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceGrant,
@@ -89,10 +90,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
-			Factory:  ResourceKeyPolicy,
-			TypeName: "aws_kms_key_policy",
+			Factory:             ResourceKeyPolicy,
+			TypeName:            "aws_kms_key_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceReplicaExternalKey,
@@ -101,6 +104,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceReplicaKey,
@@ -109,6 +113,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
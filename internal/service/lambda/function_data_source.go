@@ -176,6 +176,10 @@ func DataSourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"runtime_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -332,6 +336,11 @@ func dataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+	if function.RuntimeVersionConfig != nil {
+		d.Set("runtime_version_arn", function.RuntimeVersionConfig.RuntimeVersionArn)
+	} else {
+		d.Set("runtime_version_arn", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	d.Set("source_code_hash", function.CodeSha256)
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_destination_policy")
+// @IAMPolicyAttributes("access_policy")
 func resourceDestinationPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDestinationPolicyPut,
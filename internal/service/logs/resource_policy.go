@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_resource_policy")
+// @IAMPolicyAttributes("policy_document")
 func resourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut,
@@ -54,8 +54,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  resourceDestinationPolicy,
-			TypeName: "aws_cloudwatch_log_destination_policy",
+			Factory:             resourceDestinationPolicy,
+			TypeName:            "aws_cloudwatch_log_destination_policy",
+			IAMPolicyAttributes: []string{"access_policy"},
 		},
 		{
 			Factory:  resourceGroup,
@@ -68,8 +69,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cloudwatch_log_metric_filter",
 		},
 		{
-			Factory:  resourceResourcePolicy,
-			TypeName: "aws_cloudwatch_log_resource_policy",
+			Factory:             resourceResourcePolicy,
+			TypeName:            "aws_cloudwatch_log_resource_policy",
+			IAMPolicyAttributes: []string{"policy_document"},
 		},
 		{
 			Factory:  resourceStream,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_media_store_container_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceContainerPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContainerPolicyPut,
@@ -37,8 +37,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceContainerPolicy,
-			TypeName: "aws_media_store_container_policy",
+			Factory:             ResourceContainerPolicy,
+			TypeName:            "aws_media_store_container_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_networkfirewall_resource_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut,
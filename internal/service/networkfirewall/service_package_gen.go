@@ -63,8 +63,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_networkfirewall_logging_configuration",
 		},
 		{
-			Factory:  ResourceResourcePolicy,
-			TypeName: "aws_networkfirewall_resource_policy",
+			Factory:             ResourceResourcePolicy,
+			TypeName:            "aws_networkfirewall_resource_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceRuleGroup,
@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_opensearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @IAMPolicyAttributes("access_policies")
 func ResourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
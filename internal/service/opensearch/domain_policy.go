@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_opensearch_domain_policy")
+// @IAMPolicyAttributes("access_policies")
 func ResourceDomainPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainPolicyUpsert,
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application")
+func newApplicationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationDataSource{}, nil
+}
+
+type applicationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *applicationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_application"
+}
+
+func (d *applicationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"application_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"attachments_configuration": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[attachmentsConfigurationModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[attachmentsConfigurationModel](ctx),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"display_name": schema.StringAttribute{
+				Required: true,
+			},
+			"encryption_configuration": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionConfigurationModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[encryptionConfigurationModel](ctx),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *applicationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().QBusinessClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	name := data.DisplayName.ValueString()
+	output, err := findApplicationByDisplayName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Application (%s)", name), tfresource.SingularDataSourceFindError("Amazon Q Business Application", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.ApplicationId)
+
+	arn := aws.ToString(output.ApplicationArn)
+	tags, err := listTags(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Application (%s) tags", arn), err.Error())
+
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findApplicationByDisplayName(ctx context.Context, conn *qbusiness.Client, name string) (*qbusiness.GetApplicationOutput, error) {
+	input := &qbusiness.ListApplicationsInput{}
+	var applications []awstypes.Application
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			if aws.ToString(v.DisplayName) == name {
+				applications = append(applications, v)
+			}
+		}
+	}
+
+	application, err := tfresource.AssertSingleValueResult(applications)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findApplicationByID(ctx, conn, aws.ToString(application.ApplicationId))
+}
+
+type applicationDataSourceModel struct {
+	ApplicationARN           types.String                                                   `tfsdk:"application_arn"`
+	ApplicationID            types.String                                                   `tfsdk:"application_id"`
+	AttachmentsConfiguration fwtypes.ListNestedObjectValueOf[attachmentsConfigurationModel] `tfsdk:"attachments_configuration"`
+	CreatedAt                timetypes.RFC3339                                              `tfsdk:"created_at"`
+	Description              types.String                                                   `tfsdk:"description"`
+	DisplayName              types.String                                                   `tfsdk:"display_name"`
+	EncryptionConfiguration  fwtypes.ListNestedObjectValueOf[encryptionConfigurationModel]  `tfsdk:"encryption_configuration"`
+	ID                       types.String                                                   `tfsdk:"id"`
+	RoleARN                  types.String                                                   `tfsdk:"role_arn"`
+	Status                   types.String                                                   `tfsdk:"status"`
+	Tags                     types.Map                                                      `tfsdk:"tags"`
+	UpdatedAt                timetypes.RFC3339                                              `tfsdk:"updated_at"`
+}
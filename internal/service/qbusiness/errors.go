@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// setErrorDetail sets the last error from a failed resource's error details.
+func setErrorDetail(err error, detail *awstypes.ErrorDetail) {
+	if detail != nil && detail.ErrorMessage != nil {
+		tfresource.SetLastError(err, errors.New(aws.ToString(detail.ErrorMessage)))
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+)
+
+func RegisterSweepers() {
+	// Deleting an application deletes all of its indices, retrievers, data sources, plugins and web experiences.
+	sweep.Register("aws_qbusiness_application", sweepApplications)
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+	input := &qbusiness.ListApplicationsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sweepResources, err
+		}
+
+		for _, v := range page.Applications {
+			id := aws.ToString(v.ApplicationId)
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute("id", id),
+				framework.NewAttribute("application_id", id),
+			).WithImportID(id))
+		}
+	}
+
+	return sweepResources, nil
+}
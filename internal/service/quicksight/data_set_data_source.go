@@ -686,7 +686,7 @@ func dataSourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta int
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig, "aws_quicksight_data_set").Map()); err != nil {
 		return diag.Errorf("setting tags: %s", err)
 	}
 
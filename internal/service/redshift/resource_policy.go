@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_redshift_resource_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut,
@@ -140,8 +140,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_redshift_partner",
 		},
 		{
-			Factory:  ResourceResourcePolicy,
-			TypeName: "aws_redshift_resource_policy",
+			Factory:             ResourceResourcePolicy,
+			TypeName:            "aws_redshift_resource_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceScheduledAction,
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_redshiftserverless_resource_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourcePolicyPut,
@@ -58,8 +58,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceResourcePolicy,
-			TypeName: "aws_redshiftserverless_resource_policy",
+			Factory:             ResourceResourcePolicy,
+			TypeName:            "aws_redshiftserverless_resource_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceSnapshot,
@@ -476,7 +476,7 @@ func resourceBucketObjectUpload(ctx context.Context, d *schema.ResourceData, met
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := tftags.New(ctx, getContextTags(ctx))
-	tags = defaultTagsConfig.MergeTags(tags)
+	tags = defaultTagsConfig.MergeTags(tags, "aws_s3_bucket_object")
 	if len(tags) > 0 {
 		// The tag-set must be encoded as URL Query parameters.
 		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_policy", name="Bucket Policy")
+// @IAMPolicyAttributes("policy")
 func resourceBucketPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketPolicyPut,
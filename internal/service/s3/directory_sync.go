@@ -0,0 +1,779 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	slashpath "path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum number of concurrent object uploads.
+	directorySyncUploadConcurrency = 8
+	// Maximum number of objects in a DeleteObjects request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// @FrameworkResource(name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *directorySyncResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_sync"
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChecksumAlgorithm](),
+				Optional:   true,
+				Computed:   true,
+				// ETags are not MD5 hashes of the object's contents for SSE-KMS, SSE-C or multipart uploads,
+				// so a checksum is needed to detect modified objects.
+				Default: stringdefault.StaticString(string(awstypes.ChecksumAlgorithmCrc32)),
+			},
+			"delete_removed_objects": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"content_type_override": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[contentTypeOverrideModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							Required: true,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.setID()
+
+	files, manifest, err := data.walkSource()
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) source", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	overrides, diags := data.ContentTypeOverrides.ToSlice(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	if err := data.upload(ctx, conn, files, overrides, optFns...); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Manifest = flex.FlattenFrameworkStringValueMap(ctx, manifest)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	err := findBucket(ctx, conn, data.Bucket.ValueString(), optFns...)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	manifest := flex.ExpandFrameworkStringValueMap(ctx, data.Manifest)
+	keyPrefix := data.KeyPrefix.ValueString()
+	keys := make([]string, 0, len(manifest))
+	for k := range manifest {
+		keys = append(keys, keyPrefix+k)
+	}
+
+	checksumAlgorithm := data.ChecksumAlgorithm.ValueEnum()
+	checksums, err := findObjectChecksumsByBucketAndKeys(ctx, conn, data.Bucket.ValueString(), keys, checksumAlgorithm, optFns...)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Remove any objects that no longer exist from the manifest so that they are uploaded again,
+	// and record the checksums of any that have been modified so that they show as drift.
+	for k, v := range manifest {
+		checksum, ok := checksums[keyPrefix+k]
+
+		if !ok {
+			log.Printf("[WARN] S3 Directory Sync (%s) object (%s) not found, removing from manifest", data.ID.ValueString(), k)
+			delete(manifest, k)
+
+			continue
+		}
+
+		// Without a checksum algorithm only the ETag is available, which isn't a hash of the object's contents for all objects.
+		// Checksums of multipart uploads aren't checksums of the whole object and can't be compared.
+		if checksumAlgorithm != "" && checksum != v && !isMultipartObjectChecksum(checksum) {
+			log.Printf("[WARN] S3 Directory Sync (%s) object (%s) modified", data.ID.ValueString(), k)
+			manifest[k] = checksum
+		}
+	}
+
+	data.Manifest = flex.FlattenFrameworkStringValueMap(ctx, manifest)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directorySyncResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	files, manifest, err := new.walkSource()
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) source", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	overrides, diags := new.ContentTypeOverrides.ToSlice(ctx)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to the checksum algorithm or content types require all objects to be uploaded again.
+	uploadAll := !new.ChecksumAlgorithm.Equal(old.ChecksumAlgorithm) || !new.ContentTypeOverrides.Equal(old.ContentTypeOverrides)
+	oldManifest := flex.ExpandFrameworkStringValueMap(ctx, old.Manifest)
+	changed := make(map[string]string)
+	for k, v := range manifest {
+		if uploadAll || oldManifest[k] != v {
+			changed[k] = files[k]
+		}
+	}
+
+	conn, optFns := r.conn(ctx, new.Bucket.ValueString())
+
+	if err := new.upload(ctx, conn, changed, overrides, optFns...); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if new.DeleteRemovedObjects.ValueBool() {
+		var removed []string
+		for k := range oldManifest {
+			if _, ok := manifest[k]; !ok {
+				removed = append(removed, k)
+			}
+		}
+
+		if err := new.delete(ctx, conn, removed, optFns...); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	new.Manifest = flex.FlattenFrameworkStringValueMap(ctx, manifest)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Uploaded objects are only deleted if objects are deleted when their files are removed.
+	if !data.DeleteRemovedObjects.ValueBool() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	manifest := flex.ExpandFrameworkStringValueMap(ctx, data.Manifest)
+	keys := make([]string, 0, len(manifest))
+	for k := range manifest {
+		keys = append(keys, k)
+	}
+
+	if err := data.delete(ctx, conn, keys, optFns...); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// ModifyPlan computes the planned manifest from the contents of the local source directory.
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data directorySyncResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Source.IsUnknown() || data.ChecksumAlgorithm.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("manifest"), types.MapUnknown(types.StringType))...)
+
+		return
+	}
+
+	if !data.ContentTypeOverrides.IsUnknown() {
+		overrides, diags := data.ContentTypeOverrides.ToSlice(ctx)
+
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for i, v := range overrides {
+			if _, err := slashpath.Match(v.Pattern.ValueString(), ""); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root("content_type_override").AtListIndex(i).AtName("pattern"), "Invalid Pattern", err.Error())
+			}
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_, manifest, err := data.walkSource()
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Source", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("manifest"), flex.FlattenFrameworkStringValueMap(ctx, manifest))...)
+}
+
+// conn returns the S3 API client and any request options for the specified bucket.
+func (r *directorySyncResource) conn(ctx context.Context, bucket string) (*s3.Client, []func(*s3.Options)) {
+	conn := r.Meta().S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+type directorySyncResourceModel struct {
+	Bucket               types.String                                              `tfsdk:"bucket"`
+	ChecksumAlgorithm    fwtypes.StringEnum[awstypes.ChecksumAlgorithm]            `tfsdk:"checksum_algorithm"`
+	ContentTypeOverrides fwtypes.ListNestedObjectValueOf[contentTypeOverrideModel] `tfsdk:"content_type_override"`
+	DeleteRemovedObjects types.Bool                                                `tfsdk:"delete_removed_objects"`
+	ID                   types.String                                              `tfsdk:"id"`
+	KeyPrefix            types.String                                              `tfsdk:"key_prefix"`
+	Manifest             types.Map                                                 `tfsdk:"manifest"`
+	Source               types.String                                              `tfsdk:"source"`
+}
+
+type contentTypeOverrideModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Pattern     types.String `tfsdk:"pattern"`
+}
+
+func (data *directorySyncResourceModel) setID() {
+	data.ID = types.StringValue(data.Bucket.ValueString() + "/" + data.KeyPrefix.ValueString())
+}
+
+// walkSource walks the local source directory.
+// Returns a map of relative (slash-separated) file path to local file path and
+// a map of relative file path to file checksum.
+func (data *directorySyncResourceModel) walkSource() (map[string]string, map[string]string, error) {
+	source, err := homedir.Expand(data.Source.ValueString())
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", data.Source.ValueString(), err)
+	}
+
+	files := make(map[string]string)
+	manifest := make(map[string]string)
+	checksumAlgorithm := data.ChecksumAlgorithm.ValueEnum()
+
+	err = filepath.WalkDir(source, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to regular files.
+		fi, err := os.Stat(name)
+
+		if err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, name)
+
+		if err != nil {
+			return err
+		}
+
+		checksum, err := fileChecksum(name, checksumAlgorithm)
+
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		files[key] = name
+		manifest[key] = checksum
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return files, manifest, nil
+}
+
+// upload uploads the specified files (relative path to local file path) concurrently.
+func (data *directorySyncResourceModel) upload(ctx context.Context, conn *s3.Client, files map[string]string, overrides []*contentTypeOverrideModel, optFns ...func(*s3.Options)) error {
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	checksumAlgorithm := data.ChecksumAlgorithm.ValueEnum()
+
+	var (
+		errs []error
+		mu   sync.Mutex
+		sem  = make(chan struct{}, directorySyncUploadConcurrency)
+		wg   sync.WaitGroup
+	)
+
+	for k, name := range files {
+		k, name := k, name
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := uploadFile(ctx, uploader, bucket, keyPrefix+k, name, contentTypeFor(k, overrides), checksumAlgorithm); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// delete deletes the objects with the specified relative keys.
+func (data *directorySyncResourceModel) delete(ctx context.Context, conn *s3.Client, keys []string, optFns ...func(*s3.Options)) error {
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(keys, directorySyncDeleteBatchSize) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &awstypes.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(v string) awstypes.ObjectIdentifier {
+					return awstypes.ObjectIdentifier{
+						Key: aws.String(keyPrefix + v),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input, optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			if aws.ToString(v.Code) == errCodeNoSuchKey {
+				continue
+			}
+
+			errs = append(errs, newDeleteObjectVersionError(v))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nil
+}
+
+func uploadFile(ctx context.Context, uploader *manager.Uploader, bucket, key, name, contentType string, checksumAlgorithm awstypes.ChecksumAlgorithm) error {
+	file, err := os.Open(name)
+
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", name, err)
+	}
+
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", name, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:              file,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: checksumAlgorithm,
+		Key:               aws.String(key),
+	}
+
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return nil
+}
+
+// fileChecksum returns the checksum of the specified file's contents.
+// If no checksum algorithm is specified the hex-encoded MD5 hash (the ETag of a single part upload) is returned,
+// otherwise the base64-encoded checksum (as returned by S3) is returned.
+func fileChecksum(name string, checksumAlgorithm awstypes.ChecksumAlgorithm) (string, error) {
+	var h hash.Hash
+
+	switch checksumAlgorithm {
+	case awstypes.ChecksumAlgorithmCrc32:
+		h = crc32.NewIEEE()
+	case awstypes.ChecksumAlgorithmCrc32c:
+		h = crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case awstypes.ChecksumAlgorithmSha1:
+		h = sha1.New()
+	case awstypes.ChecksumAlgorithmSha256:
+		h = sha256.New()
+	default:
+		h = md5.New()
+	}
+
+	file, err := os.Open(name)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("reading (%s): %w", name, err)
+	}
+
+	if checksumAlgorithm == "" {
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// contentTypeFor returns the content type for the specified relative (slash-separated) file path.
+// The first matching override is used. Patterns without a '/' are matched against the file's base name.
+// Otherwise the content type is determined from the file's extension.
+func contentTypeFor(key string, overrides []*contentTypeOverrideModel) string {
+	for _, v := range overrides {
+		pattern := v.Pattern.ValueString()
+		name := key
+		if !strings.Contains(pattern, "/") {
+			name = slashpath.Base(key)
+		}
+
+		if ok, _ := slashpath.Match(pattern, name); ok {
+			return v.ContentType.ValueString()
+		}
+	}
+
+	return mime.TypeByExtension(slashpath.Ext(key))
+}
+
+// findObjectChecksumsByBucketAndKeys returns a map of object key to checksum for those of the specified objects that exist.
+// Only objects sharing the keys' longest common directory prefix are listed.
+// If no checksum algorithm is specified the object's ETag is returned, otherwise the object's checksum (as returned by S3) is returned.
+func findObjectChecksumsByBucketAndKeys(ctx context.Context, conn *s3.Client, bucket string, keys []string, checksumAlgorithm awstypes.ChecksumAlgorithm, optFns ...func(*s3.Options)) (map[string]string, error) {
+	checksums := make(map[string]string)
+
+	if len(keys) == 0 {
+		return checksums, nil
+	}
+
+	wanted := make(map[string]struct{}, len(keys))
+	for _, v := range keys {
+		wanted[v] = struct{}{}
+	}
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	// Directory buckets only support prefixes that end in a delimiter.
+	if prefix := longestCommonPrefix(keys); strings.Contains(prefix, "/") {
+		input.Prefix = aws.String(prefix[:strings.LastIndex(prefix, "/")+1])
+	}
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			key := aws.ToString(v.Key)
+			if _, ok := wanted[key]; ok {
+				checksums[key] = strings.Trim(aws.ToString(v.ETag), `"`)
+			}
+		}
+	}
+
+	if checksumAlgorithm == "" {
+		return checksums, nil
+	}
+
+	// Object listings don't include checksums.
+	var (
+		errs []error
+		mu   sync.Mutex
+		sem  = make(chan struct{}, directorySyncUploadConcurrency)
+		wg   sync.WaitGroup
+	)
+
+	for key := range checksums {
+		key := key
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(checksumAlgorithm), optFns...)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case tfresource.NotFound(err):
+				delete(checksums, key)
+			case err != nil:
+				errs = append(errs, fmt.Errorf("reading S3 Object (%s): %w", key, err))
+			default:
+				checksums[key] = objectChecksum(output, checksumAlgorithm)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return checksums, nil
+}
+
+// objectChecksum returns the object's checksum for the specified algorithm.
+func objectChecksum(output *s3.HeadObjectOutput, checksumAlgorithm awstypes.ChecksumAlgorithm) string {
+	switch checksumAlgorithm {
+	case awstypes.ChecksumAlgorithmCrc32:
+		return aws.ToString(output.ChecksumCRC32)
+	case awstypes.ChecksumAlgorithmCrc32c:
+		return aws.ToString(output.ChecksumCRC32C)
+	case awstypes.ChecksumAlgorithmSha1:
+		return aws.ToString(output.ChecksumSHA1)
+	case awstypes.ChecksumAlgorithmSha256:
+		return aws.ToString(output.ChecksumSHA256)
+	default:
+		return strings.Trim(aws.ToString(output.ETag), `"`)
+	}
+}
+
+// isMultipartObjectChecksum returns whether the specified ETag or checksum is that of a multipart upload, e.g. `<checksum>-<part count>`.
+// Neither hex nor standard base64 encoding uses '-'.
+func isMultipartObjectChecksum(checksum string) bool {
+	return strings.Contains(checksum, "-")
+}
+
+// longestCommonPrefix returns the longest common prefix of the specified strings.
+func longestCommonPrefix(s []string) string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	prefix := s[0]
+	for _, v := range s[1:] {
+		for !strings.HasPrefix(v, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncContentTypeFor(t *testing.T) {
+	t.Parallel()
+
+	overrides := []*tfs3.ContentTypeOverrideModel{
+		{Pattern: basetypes.NewStringValue("*.md"), ContentType: basetypes.NewStringValue("text/markdown")},
+		{Pattern: basetypes.NewStringValue("assets/*"), ContentType: basetypes.NewStringValue("application/octet-stream")},
+		{Pattern: basetypes.NewStringValue("*.txt"), ContentType: basetypes.NewStringValue("text/x-custom")},
+	}
+
+	testCases := map[string]struct {
+		key       string
+		overrides []*tfs3.ContentTypeOverrideModel
+		expected  string
+	}{
+		"no overrides": {
+			key:      "index.html",
+			expected: "text/html; charset=utf-8",
+		},
+		"no overrides unknown extension": {
+			key:      "data.unknown-extension",
+			expected: "",
+		},
+		"base name pattern": {
+			key:       "docs/README.md",
+			overrides: overrides,
+			expected:  "text/markdown",
+		},
+		"path pattern": {
+			key:       "assets/logo.png",
+			overrides: overrides,
+			expected:  "application/octet-stream",
+		},
+		"path pattern no match in subdirectory": {
+			key:       "assets/images/logo.png",
+			overrides: overrides,
+			expected:  "image/png",
+		},
+		"first match wins": {
+			key:       "assets/notes.txt",
+			overrides: overrides,
+			expected:  "application/octet-stream",
+		},
+		"extension fallback": {
+			key:       "index.html",
+			overrides: overrides,
+			expected:  "text/html; charset=utf-8",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfs3.ContentTypeFor(testCase.key, testCase.overrides), testCase.expected; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncFileChecksum(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "test.txt")
+	if err := os.WriteFile(filename, []byte("hello world"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		checksumAlgorithm types.ChecksumAlgorithm
+		expected          string
+	}{
+		"MD5": {
+			expected: "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		},
+		"CRC32": {
+			checksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			expected:          "DUoRhQ==",
+		},
+		"CRC32C": {
+			checksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+			expected:          "yZRlqg==",
+		},
+		"SHA1": {
+			checksumAlgorithm: types.ChecksumAlgorithmSha1,
+			expected:          "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		},
+		"SHA256": {
+			checksumAlgorithm: types.ChecksumAlgorithmSha256,
+			expected:          "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.FileChecksum(filename, testCase.checksumAlgorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if expected := testCase.expected; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+
+	if _, err := tfs3.FileChecksum(filepath.Join(t.TempDir(), "missing.txt"), ""); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_override.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed_objects", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "index.html", types.ChecksumAlgorithmCrc32),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "css/site.css", types.ChecksumAlgorithmCrc32),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceDirectorySync, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, map[string]string{
+		"index.html": "<html></html>",
+		"old.txt":    "old",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemovedObjects(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed_objects", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "index.html", types.ChecksumAlgorithmCrc32),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "old.txt", types.ChecksumAlgorithmCrc32),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "old.txt"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, source, map[string]string{
+						"index.html": "<html><body></body></html>",
+						"new.txt":    "new",
+					})
+					testAccDirectorySyncRemoveFiles(t, source, "old.txt")
+				},
+				Config: testAccDirectorySyncConfig_deleteRemovedObjects(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "index.html", types.ChecksumAlgorithmCrc32),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "new.txt", types.ChecksumAlgorithmCrc32),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.old.txt"),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "new.txt"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "old.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_checksumAlgorithm(rName, source, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "index.html", types.ChecksumAlgorithmSha256),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_checksumAlgorithm(rName, source, "CRC32C"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
+					testAccCheckDirectorySyncManifestChecksum(resourceName, source, "index.html", types.ChecksumAlgorithmCrc32c),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_contentTypeOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, map[string]string{
+		"index.html":           "<html></html>",
+		".well-known/security": "Contact: mailto:security@example.com",
+		"docs/readme.md":       "# Example",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_contentTypeOverride(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content_type_override.#", "2"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, ".well-known/security", "text/plain"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "docs/readme.md", "text/markdown"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			// Objects are only deleted if removed objects are deleted.
+			if rs.Primary.Attributes["delete_removed_objects"] != "true" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				key, ok := strings.CutPrefix(k, "manifest.")
+				if !ok || key == "%" {
+					continue
+				}
+
+				key = rs.Primary.Attributes["key_prefix"] + key
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"]+key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"]+key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, key)
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key_prefix"]+key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Directory Sync %s object %s content type: got %s, expected %s", rs.Primary.ID, key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncManifestChecksum(n, source, key string, checksumAlgorithm types.ChecksumAlgorithm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		checksum, err := tfs3.FileChecksum(filepath.Join(source, filepath.FromSlash(key)), checksumAlgorithm)
+
+		if err != nil {
+			return err
+		}
+
+		return resource.TestCheckResourceAttr(n, "manifest."+key, checksum)(s)
+	}
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for k, v := range files {
+		name := filepath.Join(dir, filepath.FromSlash(k))
+
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncRemoveFiles(t *testing.T, dir string, files ...string) {
+	t.Helper()
+
+	for _, v := range files {
+		if err := os.Remove(filepath.Join(dir, filepath.FromSlash(v))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_deleteRemovedObjects(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket                 = aws_s3_bucket.test.bucket
+  key_prefix             = "site/"
+  source                 = %[1]q
+  delete_removed_objects = true
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_checksumAlgorithm(rName, source, checksumAlgorithm string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  source             = %[1]q
+  checksum_algorithm = %[2]q
+}
+`, source, checksumAlgorithm))
+}
+
+func testAccDirectorySyncConfig_contentTypeOverride(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+
+  content_type_override {
+    pattern      = ".well-known/*"
+    content_type = "text/plain"
+  }
+
+  content_type_override {
+    pattern      = "*.md"
+    content_type = "text/markdown"
+  }
+}
+`, source))
+}
@@ -564,9 +564,9 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := tftags.New(ctx, getContextTags(ctx))
 	if ignoreProviderDefaultTags(ctx, d) {
-		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
+		tags = tags.RemoveDefaultConfig(defaultTagsConfig, "aws_s3_object")
 	} else {
-		tags = defaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+		tags = defaultTagsConfig.MergeTags(tftags.New(ctx, tags), "aws_s3_object")
 	}
 
 	if len(tags) > 0 {
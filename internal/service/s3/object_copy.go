@@ -647,7 +647,7 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := tftags.New(ctx, getContextTags(ctx))
-	tags = defaultTagsConfig.MergeTags(tags)
+	tags = defaultTagsConfig.MergeTags(tags, "aws_s3_object_copy")
 	if len(tags) > 0 {
 		// The tag-set must be encoded as URL Query parameters.
 		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
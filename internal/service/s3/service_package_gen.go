@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
 		},
+		{
+			Factory: newDirectorySyncResource,
+			Name:    "Directory Sync",
+		},
 	}
 }
 
@@ -80,6 +84,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "bucket",
 				ResourceType:        "Bucket",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
@@ -151,9 +156,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Bucket Ownership Controls",
 		},
 		{
-			Factory:  resourceBucketPolicy,
-			TypeName: "aws_s3_bucket_policy",
-			Name:     "Bucket Policy",
+			Factory:             resourceBucketPolicy,
+			TypeName:            "aws_s3_bucket_policy",
+			Name:                "Bucket Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceBucketPublicAccessBlock,
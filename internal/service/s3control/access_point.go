@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_s3_access_point")
+// @IAMPolicyAttributes("policy")
 func resourceAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPointCreate,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_s3control_access_point_policy")
+// @IAMPolicyAttributes("policy")
 func resourceAccessPointPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPointPolicyCreate,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_s3control_bucket_policy")
+// @IAMPolicyAttributes("policy")
 func resourceBucketPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketPolicyCreate,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_s3control_object_lambda_access_point_policy")
+// @IAMPolicyAttributes("policy")
 func resourceObjectLambdaAccessPointPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceObjectLambdaAccessPointPolicyCreate,
@@ -59,8 +59,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  resourceAccessPoint,
-			TypeName: "aws_s3_access_point",
+			Factory:             resourceAccessPoint,
+			TypeName:            "aws_s3_access_point",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceAccountPublicAccessBlock,
@@ -68,8 +69,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Account Public Access Block",
 		},
 		{
-			Factory:  resourceAccessPointPolicy,
-			TypeName: "aws_s3control_access_point_policy",
+			Factory:             resourceAccessPointPolicy,
+			TypeName:            "aws_s3control_access_point_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceBucket,
@@ -82,8 +84,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3control_bucket_lifecycle_configuration",
 		},
 		{
-			Factory:  resourceBucketPolicy,
-			TypeName: "aws_s3control_bucket_policy",
+			Factory:             resourceBucketPolicy,
+			TypeName:            "aws_s3control_bucket_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceMultiRegionAccessPoint,
@@ -98,8 +101,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3control_object_lambda_access_point",
 		},
 		{
-			Factory:  resourceObjectLambdaAccessPointPolicy,
-			TypeName: "aws_s3control_object_lambda_access_point_policy",
+			Factory:             resourceObjectLambdaAccessPointPolicy,
+			TypeName:            "aws_s3control_object_lambda_access_point_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceStorageLensConfiguration,
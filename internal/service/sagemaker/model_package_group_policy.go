@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_sagemaker_model_package_group_policy")
+// @IAMPolicyAttributes("resource_policy")
 func ResourceModelPackageGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceModelPackageGroupPolicyPut,
@@ -155,8 +155,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceModelPackageGroupPolicy,
-			TypeName: "aws_sagemaker_model_package_group_policy",
+			Factory:             ResourceModelPackageGroupPolicy,
+			TypeName:            "aws_sagemaker_model_package_group_policy",
+			IAMPolicyAttributes: []string{"resource_policy"},
 		},
 		{
 			Factory:  ResourceMonitoringSchedule,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_schemas_registry_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceRegistryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegistryPolicyCreate,
@@ -46,8 +46,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceRegistryPolicy,
-			TypeName: "aws_schemas_registry_policy",
+			Factory:             ResourceRegistryPolicy,
+			TypeName:            "aws_schemas_registry_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceSchema,
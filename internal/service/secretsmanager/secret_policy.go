@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_secretsmanager_secret_policy", name="Secret Policy")
+// @IAMPolicyAttributes("policy")
 func resourceSecretPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSecretPolicyCreate,
@@ -61,11 +61,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
-			Factory:  resourceSecretPolicy,
-			TypeName: "aws_secretsmanager_secret_policy",
-			Name:     "Secret Policy",
+			Factory:             resourceSecretPolicy,
+			TypeName:            "aws_secretsmanager_secret_policy",
+			Name:                "Secret Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceSecretRotation,
@@ -126,7 +126,7 @@ func (r *customLogSourceResource) Schema(ctx context.Context, request resource.S
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"role_arn": schema.StringAttribute{
-										CustomType: fwtypes.ARNType,
+										CustomType: fwtypes.ARNOf("iam", "role"),
 										Required:   true,
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.RequiresReplace(),
@@ -337,7 +337,7 @@ type customLogSourceConfigurationModel struct {
 }
 
 type customLogSourceCrawlerConfigurationModel struct {
-	RoleArn types.String `tfsdk:"role_arn"`
+	RoleArn fwtypes.ServiceARN `tfsdk:"role_arn"`
 }
 
 type customLogSourceProviderIdentityModel struct {
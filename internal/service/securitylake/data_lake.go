@@ -64,7 +64,7 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 			"arn":        framework.ARNAttributeComputedOnly(),
 			names.AttrID: framework.IDAttribute(),
 			"meta_store_manager_role_arn": schema.StringAttribute{
-				CustomType: fwtypes.ARNType,
+				CustomType: fwtypes.ARNOf("iam", "role"),
 				Required:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -154,7 +154,7 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 										Optional:    true,
 									},
 									"role_arn": schema.StringAttribute{
-										CustomType: fwtypes.ARNType,
+										CustomType: fwtypes.ARNOf("iam", "role"),
 										Optional:   true,
 									},
 								},
@@ -519,7 +519,7 @@ type dataLakeResourceModel struct {
 	Configurations          fwtypes.ListNestedObjectValueOf[dataLakeConfigurationModel] `tfsdk:"configuration"`
 	DataLakeARN             types.String                                                `tfsdk:"arn"`
 	ID                      types.String                                                `tfsdk:"id"`
-	MetaStoreManagerRoleARN fwtypes.ARN                                                 `tfsdk:"meta_store_manager_role_arn"`
+	MetaStoreManagerRoleARN fwtypes.ServiceARN                                          `tfsdk:"meta_store_manager_role_arn"`
 	S3BucketARN             types.String                                                `tfsdk:"s3_bucket_arn"`
 	Tags                    types.Map                                                   `tfsdk:"tags"`
 	TagsAll                 types.Map                                                   `tfsdk:"tags_all"`
@@ -563,5 +563,5 @@ type dataLakeLifecycleTransitionModel struct {
 
 type dataLakeReplicationConfigurationModel struct {
 	Regions fwtypes.SetValueOf[types.String] `tfsdk:"regions"`
-	RoleARN fwtypes.ARN                      `tfsdk:"role_arn"`
+	RoleARN fwtypes.ServiceARN               `tfsdk:"role_arn"`
 }
@@ -101,7 +101,7 @@ func (r *subscriberNotificationResource) Schema(ctx context.Context, req resourc
 										Optional:   true,
 									},
 									"target_role_arn": schema.StringAttribute{
-										CustomType: fwtypes.ARNType,
+										CustomType: fwtypes.ARNOf("iam", "role"),
 										Optional:   true,
 									},
 								},
@@ -391,5 +391,5 @@ type httpsNotificationConfigurationModel struct {
 	AuthorizationAPIKeyValue types.String                            `tfsdk:"authorization_api_key_value"`
 	Endpoint                 types.String                            `tfsdk:"endpoint"`
 	HTTPMethod               fwtypes.StringEnum[awstypes.HttpMethod] `tfsdk:"http_method"`
-	TargetRoleARN            fwtypes.ARN                             `tfsdk:"target_role_arn"`
+	TargetRoleARN            fwtypes.ServiceARN                      `tfsdk:"target_role_arn"`
 }
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_ses_identity_policy")
+// @IAMPolicyAttributes("policy")
 func ResourceIdentityPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIdentityPolicyCreate,
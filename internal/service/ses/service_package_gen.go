@@ -79,8 +79,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ses_identity_notification_topic",
 		},
 		{
-			Factory:  ResourceIdentityPolicy,
-			TypeName: "aws_ses_identity_policy",
+			Factory:             ResourceIdentityPolicy,
+			TypeName:            "aws_ses_identity_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceReceiptFilter,
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_sesv2_email_identity_policy", name="Email Identity Policy")
+// @IAMPolicyAttributes("policy")
 func ResourceEmailIdentityPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEmailIdentityPolicyCreate,
@@ -102,9 +102,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_sesv2_email_identity_mail_from_attributes",
 		},
 		{
-			Factory:  ResourceEmailIdentityPolicy,
-			TypeName: "aws_sesv2_email_identity_policy",
-			Name:     "Email Identity Policy",
+			Factory:             ResourceEmailIdentityPolicy,
+			TypeName:            "aws_sesv2_email_identity_policy",
+			Name:                "Email Identity Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 	}
 }
@@ -48,14 +48,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
 			TypeName: "aws_sns_topic_data_protection_policy",
 		},
 		{
-			Factory:  resourceTopicPolicy,
-			TypeName: "aws_sns_topic_policy",
+			Factory:             resourceTopicPolicy,
+			TypeName:            "aws_sns_topic_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceTopicSubscription,
@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_sns_topic_policy")
+// @IAMPolicyAttributes("policy")
 func resourceTopicPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTopicPolicyUpsert,
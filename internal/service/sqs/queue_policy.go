@@ -12,6 +12,7 @@ import (
 )
 
 // @SDKResource("aws_sqs_queue_policy")
+// @IAMPolicyAttributes("policy")
 func resourceQueuePolicy() *schema.Resource {
 	h := &queueAttributeHandler{
 		AttributeName: types.QueueAttributeNamePolicy,
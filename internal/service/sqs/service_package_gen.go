@@ -44,10 +44,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "id",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
-			Factory:  resourceQueuePolicy,
-			TypeName: "aws_sqs_queue_policy",
+			Factory:             resourceQueuePolicy,
+			TypeName:            "aws_sqs_queue_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceQueueRedriveAllowPolicy,
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_ssoadmin_permission_set_inline_policy")
+// @IAMPolicyAttributes("inline_policy")
 func ResourcePermissionSetInlinePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionSetInlinePolicyPut,
@@ -98,8 +98,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
-			Factory:  ResourcePermissionSetInlinePolicy,
-			TypeName: "aws_ssoadmin_permission_set_inline_policy",
+			Factory:             ResourcePermissionSetInlinePolicy,
+			TypeName:            "aws_ssoadmin_permission_set_inline_policy",
+			IAMPolicyAttributes: []string{"inline_policy"},
 		},
 		{
 			Factory:  ResourcePermissionsBoundaryAttachment,
@@ -7,14 +7,17 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
 
 // @FrameworkDataSource
+// @Cacheable
 func newDataSourceCallerIdentity(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceCallerIdentity{}
 	d.SetMigratedFromPluginSDK(true)
@@ -66,7 +69,9 @@ func (d *dataSourceCallerIdentity) Read(ctx context.Context, request datasource.
 
 	conn := d.Meta().STSClient(ctx)
 
-	output, err := FindCallerIdentity(ctx, conn)
+	output, err := conns.CachedRead(ctx, d.Meta(), "GetCallerIdentity", &sts.GetCallerIdentityInput{}, func(ctx context.Context) (*sts.GetCallerIdentityOutput, error) {
+		return FindCallerIdentity(ctx, conn)
+	})
 
 	if err != nil {
 		response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
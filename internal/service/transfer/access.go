@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_transfer_access")
+// @IAMPolicyAttributes("policy")
 func ResourceAccess() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessCreate,
@@ -37,8 +37,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
-			Factory:  ResourceAccess,
-			TypeName: "aws_transfer_access",
+			Factory:             ResourceAccess,
+			TypeName:            "aws_transfer_access",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceAgreement,
@@ -96,6 +97,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "arn",
 			},
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceWorkflow,
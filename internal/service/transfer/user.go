@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_transfer_user", name="User")
 // @Tags(identifierAttribute="arn")
+// @IAMPolicyAttributes("policy")
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
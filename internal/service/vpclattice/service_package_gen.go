@@ -63,8 +63,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceAuthPolicy,
-			TypeName: "aws_vpclattice_auth_policy",
+			Factory:             ResourceAuthPolicy,
+			TypeName:            "aws_vpclattice_auth_policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  ResourceListener,
@@ -83,9 +84,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			},
 		},
 		{
-			Factory:  ResourceResourcePolicy,
-			TypeName: "aws_vpclattice_resource_policy",
-			Name:     "Resource Policy",
+			Factory:             ResourceResourcePolicy,
+			TypeName:            "aws_vpclattice_resource_policy",
+			Name:                "Resource Policy",
+			IAMPolicyAttributes: []string{"policy"},
 		},
 		{
 			Factory:  resourceService,
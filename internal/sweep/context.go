@@ -10,8 +10,11 @@ import (
 )
 
 func Context(region string) context.Context {
-	ctx := context.Background()
+	return ContextWithRegion(context.Background(), region)
+}
 
+// ContextWithRegion returns a copy of ctx, with the parent's deadline and cancellation, that logs as a sweeper in the specified Region.
+func ContextWithRegion(ctx context.Context, region string) context.Context {
 	ctx = tfsdklog.RegisterStdlogSink(ctx)
 
 	ctx = logger(ctx, "sweeper", region)
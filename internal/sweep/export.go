@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Exportable is implemented by Sweepables that can be read, without modification, for export.
+type Exportable interface {
+	// ImportID returns the ID used to import the resource.
+	// An empty string means that the resource's import ID is not known.
+	ImportID() string
+	// ReadAttributes reads the resource and returns the values of the specified top-level string attributes.
+	// Attributes that are not set, or are not strings, are omitted.
+	// If the resource no longer exists, found is false.
+	ReadAttributes(ctx context.Context, names ...string) (attributes map[string]string, found bool, err error)
+}
+
+// ListableSweepers returns the sweepers registered via Register, which list resources separately from deleting them,
+// implemented by any of the specified service packages. An empty list of service packages matches all sweepers.
+// The returned map is keyed by sweeper name, which is the resource type name, and contains the name of the service package.
+// The names of the matching sweepers that can't list resources without deleting them are returned, sorted, in unlistable.
+func ListableSweepers(ctx context.Context, servicePackageNames ...string) (listable map[string]string, unlistable []string) {
+	services := resourceTypeServices(ctx)
+	listable = make(map[string]string)
+
+	for name, s := range sweepers {
+		if len(servicePackageNames) > 0 && !slices.Contains(servicePackageNames, services[name]) {
+			continue
+		}
+
+		if s.list == nil {
+			unlistable = append(unlistable, name)
+			continue
+		}
+
+		listable[name] = services[name]
+	}
+
+	slices.Sort(unlistable)
+
+	return listable, unlistable
+}
+
+// ImportRegionResourceTypes returns the names of the resource types whose import IDs accept an `@region` suffix.
+// These are the resource types of Regional services to which the provider adds a top-level `region` attribute,
+// i.e. those that don't already have their own `region` attribute.
+func ImportRegionResourceTypes(ctx context.Context) map[string]bool {
+	typeNames := make(map[string]bool)
+
+	for _, sp := range ServicePackages {
+		if names.IsGlobalService(sp.ServicePackageName()) {
+			continue
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if _, ok := v.Factory().SchemaMap()[names.AttrRegion]; !ok {
+				typeNames[v.TypeName] = true
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var metadataResponse resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+			var schemaResponse resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			_, hasAttribute := schemaResponse.Schema.Attributes[names.AttrRegion]
+			_, hasBlock := schemaResponse.Schema.Blocks[names.AttrRegion]
+			if !hasAttribute && !hasBlock {
+				typeNames[metadataResponse.TypeName] = true
+			}
+		}
+	}
+
+	return typeNames
+}
+
+// List runs the listing function of the named sweeper in the specified Region.
+// No resources are deleted.
+func List(ctx context.Context, region, name string) ([]Sweepable, error) {
+	s, ok := sweepers[name]
+	if !ok || s.list == nil {
+		return nil, fmt.Errorf("no sweeper registered via sweep.Register: %s", name)
+	}
+
+	ctx = logWithResourceType(ctx, name)
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+
+	return s.list(ctx, client)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestListableSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	listable, unlistable := sweep.ListableSweepers(ctx, "transcribe")
+
+	if len(listable) != 0 {
+		t.Errorf("expected no listable sweepers, got %v", listable)
+	}
+
+	if !slices.Contains(unlistable, "aws_transcribe_vocabulary") {
+		t.Errorf("expected aws_transcribe_vocabulary in unlistable sweepers, got %v", unlistable)
+	}
+
+	if !slices.IsSorted(unlistable) {
+		t.Errorf("expected unlistable sweepers to be sorted, got %v", unlistable)
+	}
+
+	listable, unlistable = sweep.ListableSweepers(ctx)
+
+	for _, v := range unlistable {
+		if _, ok := listable[v]; ok {
+			t.Errorf("sweeper %s is both listable and unlistable", v)
+		}
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -38,6 +40,7 @@ type sweepResource struct {
 	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
 	meta       *conns.AWSClient
 	attributes []attribute
+	importID   string
 }
 
 func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), meta *conns.AWSClient, attributes ...attribute) *sweepResource {
@@ -48,15 +51,68 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+// String returns the identifying attributes of the resource to be swept.
+func (sr *sweepResource) String() string {
+	attributes := make([]string, len(sr.attributes))
+	for i, attr := range sr.attributes {
+		attributes[i] = fmt.Sprintf("%s=%v", attr.path, attr.value)
+	}
+
+	return strings.Join(attributes, ",")
+}
+
+// WithImportID sets the ID used to import the resource.
+// Import ID formats vary between resources, so sweepers that support export must set it explicitly.
+func (sr *sweepResource) WithImportID(id string) *sweepResource {
+	sr.importID = id
 
+	return sr
+}
+
+// ImportID returns the ID used to import the resource, or an empty string if the sweeper didn't set one.
+func (sr *sweepResource) ImportID() string {
+	return sr.importID
+}
+
+// ReadAttributes reads the resource and returns the values of the specified top-level string attributes.
+func (sr *sweepResource) ReadAttributes(ctx context.Context, names ...string) (map[string]string, bool, error) {
+	resource, state, err := sr.state(ctx)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, false, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, false, nil
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range names {
+		var v types.String
+		if d := response.State.GetAttribute(ctx, path.Root(name), &v); d.HasError() {
+			continue
+		}
+
+		if v := v.ValueString(); v != "" {
+			attributes[name] = v
+		}
+	}
+
+	return attributes, true, nil
+}
+
+// state returns the configured resource and its state containing the identifying attributes.
+func (sr *sweepResource) state(ctx context.Context) (fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return nil, tfsdk.State{}, err
+	}
 
 	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
 
@@ -71,8 +127,24 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
+	}
+
+	return resource, state, nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	resource, state, err := sr.state(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	for _, attr := range sr.attributes {
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"slices"
+	"strings"
+)
+
+type sweeperStatus int
+
+const (
+	sweeperStatusSwept sweeperStatus = iota + 1
+	sweeperStatusSkipped
+	sweeperStatusFailed
+)
+
+// Report is the result of a sweeper run.
+type Report struct {
+	// DryRun is set if resources were listed but not deleted.
+	DryRun bool `json:"dry_run"`
+	// NotDryRunnable lists, in a dry run, the sweepers that were not run in any Region because they
+	// can't list resources without deleting them, i.e. those not registered via Register.
+	NotDryRunnable []string                 `json:"not_dry_runnable,omitempty"`
+	Regions        map[string]*RegionReport `json:"regions"`
+}
+
+// RegionReport is the result of running sweepers in a single Region.
+type RegionReport struct {
+	Swept   []SweeperReport `json:"swept"`
+	Skipped []SweeperReport `json:"skipped"`
+	Failed  []SweeperReport `json:"failed"`
+}
+
+// SweeperReport is the result of running a single sweeper in a single Region.
+type SweeperReport struct {
+	Name string `json:"name"`
+	// Resources lists the resources swept, or that would be swept in a dry run.
+	// Only sweepers registered via Register report resources.
+	Resources []string `json:"resources,omitempty"`
+	// Reason is the reason the sweeper was skipped or failed.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *RegionReport) sort() {
+	for _, v := range [][]SweeperReport{r.Swept, r.Skipped, r.Failed} {
+		slices.SortFunc(v, func(a, b SweeperReport) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+}
+
+func (r *Report) hasFailures() bool {
+	for _, v := range r.Regions {
+		if len(v.Failed) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Report) log() {
+	if len(r.NotDryRunnable) > 0 {
+		log.Printf("Sweeper Tests not dry-runnable were not run:\n")
+		for _, v := range r.NotDryRunnable {
+			log.Printf("\t- %s\n", v)
+		}
+	}
+
+	for region, v := range r.Regions {
+		if len(v.Swept) > 0 {
+			log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+			for _, v := range v.Swept {
+				log.Printf("\t- %s (%d resources)\n", v.Name, len(v.Resources))
+			}
+		}
+
+		if len(v.Skipped) > 0 {
+			log.Printf("Sweeper Tests for region (%s) were skipped:\n", region)
+			for _, v := range v.Skipped {
+				log.Printf("\t- %s: %s\n", v.Name, v.Reason)
+			}
+		}
+
+		if len(v.Failed) > 0 {
+			log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+			for _, v := range v.Failed {
+				log.Printf("\t- %s: %s\n", v.Name, v.Reason)
+			}
+		}
+	}
+}
+
+func (r *Report) write(path string) error {
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
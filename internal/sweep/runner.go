@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+const defaultServiceConcurrency = 2
+
+var (
+	flagSweepDryRun = flag.Bool("sweep-dry-run", false, "Enable to list the resources that Sweepers would delete without deleting them")
+	flagSweepReport = flag.String("sweep-report", "", "Path of a file to write a JSON report of the Sweeper run to")
+)
+
+// sweeper is a registered resource sweeper.
+type sweeper struct {
+	name         string
+	dependencies []string
+	// list lists the resources to be swept. Set for sweepers registered via Register.
+	list SweeperFn
+	// f lists and deletes the resources to be swept. Set for sweepers registered via AddTestSweepers.
+	f func(region string) error
+}
+
+// sweepers contains all registered sweepers, keyed by name.
+var sweepers = make(map[string]*sweeper)
+
+func addSweeper(s *sweeper) {
+	sweepers[s.name] = s
+}
+
+// skipError is returned when a sweeper is skipped without failing.
+type skipError struct {
+	reason string
+}
+
+func (e *skipError) Error() string {
+	return e.reason
+}
+
+// run runs the sweeper in the specified Region and returns the resources listed for sweeping.
+// In a dry run resources are listed but not deleted.
+func (s *sweeper) run(region string, dryRun bool) ([]string, error) {
+	if s.list == nil {
+		// The runner doesn't run these in a dry run. This guards against them deleting resources if it ever does.
+		if dryRun {
+			return nil, &skipError{reason: "dry run is not supported by sweepers not registered via sweep.Register"}
+		}
+
+		return nil, s.f(region)
+	}
+
+	ctx := Context(region)
+	ctx = logWithResourceType(ctx, s.name)
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+	sweepResources, err := s.list(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, &skipError{reason: err.Error()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("listing %q (%s): %w", s.name, region, err)
+	}
+
+	resources := tfslices.ApplyToAll(sweepResources, sweepableString)
+
+	if dryRun {
+		for _, v := range resources {
+			tflog.Info(ctx, "Would sweep resource", map[string]any{
+				"resource": v,
+			})
+		}
+
+		return resources, nil
+	}
+
+	err = SweepOrchestrator(ctx, sweepResources)
+	if err != nil {
+		return resources, fmt.Errorf("sweeping %q (%s): %w", s.name, region, err)
+	}
+
+	return resources, nil
+}
+
+// sweep runs the sweeper in the specified Region, deleting resources.
+// It is used when sweepers are run by resource.TestMain, which fails the run on any error, so skipped sweepers return nil.
+func (s *sweeper) sweep(region string) error {
+	_, err := s.run(region, false)
+
+	if skipErr := (*skipError)(nil); errors.As(err, &skipErr) {
+		log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", s.name, region, skipErr.reason)
+
+		return nil
+	}
+
+	return err
+}
+
+func sweepableString(s Sweepable) string {
+	if v, ok := s.(fmt.Stringer); ok {
+		return v.String()
+	}
+
+	return fmt.Sprintf("%T", s)
+}
+
+// runner runs sweepers in dependency order.
+// Sweepers whose dependencies have completed run concurrently, limited per service package.
+// Sweepers are skipped if any of their dependencies fail.
+type runner struct {
+	sweepers map[string]*sweeper
+	graph    *depgraph.Graph
+	// missing contains, for each sweeper, any dependencies that are not registered.
+	missing map[string][]string
+	// services maps sweeper names to the service package used to limit concurrency.
+	services map[string]string
+	dryRun   bool
+	// notDryRunnable contains, in a dry run, the sweepers that can't be run without deleting resources.
+	// These are sweepers not registered via Register and are not run.
+	notDryRunnable []string
+}
+
+// newRunner returns a runner for the sweepers matching filter (a comma-separated list of substrings of sweeper names) and their dependencies.
+// An empty filter matches all sweepers.
+func newRunner(sweepers map[string]*sweeper, filter string, services map[string]string, dryRun bool) (*runner, error) {
+	r := &runner{
+		sweepers: make(map[string]*sweeper),
+		graph:    depgraph.New(),
+		missing:  make(map[string][]string),
+		services: services,
+		dryRun:   dryRun,
+	}
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := r.sweepers[name]; ok {
+			return
+		}
+
+		s := sweepers[name]
+		r.sweepers[name] = s
+
+		for _, dependency := range s.dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				r.missing[name] = append(r.missing[name], dependency)
+				continue
+			}
+
+			add(dependency)
+		}
+	}
+
+	filters := tfslices.RemoveAll(strings.Split(strings.ToLower(filter), ","), "")
+	for name := range sweepers {
+		if len(filters) == 0 || slices.ContainsFunc(filters, func(f string) bool { return strings.Contains(strings.ToLower(name), f) }) {
+			add(name)
+		}
+	}
+
+	names := make([]string, 0, len(r.sweepers))
+	for name := range r.sweepers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		r.graph.AddNode(name)
+
+		if dryRun && r.sweepers[name].list == nil {
+			r.notDryRunnable = append(r.notDryRunnable, name)
+		}
+	}
+
+	for _, name := range names {
+		for _, dependency := range r.sweepers[name].dependencies {
+			if r.graph.HasNode(dependency) {
+				if err := r.graph.AddDependency(name, dependency); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	// Check for dependency cycles.
+	if _, err := r.graph.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// run runs the sweepers in each of the specified Regions, with Regions running concurrently.
+func (r *runner) run(regions []string) *Report {
+	report := &Report{
+		DryRun:         r.dryRun,
+		NotDryRunnable: r.notDryRunnable,
+		Regions:        make(map[string]*RegionReport),
+	}
+
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	for _, region := range regions {
+		region := strings.TrimSpace(region)
+		if region == "" {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			v := r.runRegion(region)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			report.Regions[region] = v
+		}()
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+func (r *runner) runRegion(region string) *RegionReport {
+	type result struct {
+		report SweeperReport
+		status sweeperStatus
+		// blocked is set if the sweeper was skipped because a dependency failed.
+		blocked bool
+	}
+
+	var (
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]result)
+		done    = make(map[string]chan struct{})
+	)
+
+	for name := range r.sweepers {
+		done[name] = make(chan struct{})
+	}
+
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+	start := time.Now()
+
+	for name, s := range r.sweepers {
+		name, s := name, s
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			dependencies, _ := r.graph.DirectDependenciesOf(name)
+			for _, dependency := range dependencies {
+				<-done[dependency]
+			}
+
+			// Sweepers that can't be dry run are reported once for all Regions and don't block their dependents.
+			if r.dryRun && s.list == nil {
+				return
+			}
+
+			v := result{
+				report: SweeperReport{Name: name},
+			}
+
+			if missing := r.missing[name]; len(missing) > 0 {
+				v.status = sweeperStatusFailed
+				v.report.Reason = fmt.Sprintf("dependencies not found: %s", strings.Join(missing, ", "))
+			} else {
+				lock.Lock()
+				var failed []string
+				for _, dependency := range dependencies {
+					if results[dependency].status == sweeperStatusFailed || results[dependency].blocked {
+						failed = append(failed, dependency)
+					}
+				}
+				lock.Unlock()
+
+				if len(failed) > 0 {
+					v.status = sweeperStatusSkipped
+					v.report.Reason = fmt.Sprintf("dependencies failed: %s", strings.Join(failed, ", "))
+					v.blocked = true
+				} else {
+					semaphore := tfsync.GetSemaphore(fmt.Sprintf("sweeper-%s-%s", region, r.service(name)), envvar.SweepServiceConcurrency, defaultServiceConcurrency)
+					semaphore.Wait()
+					resources, err := s.run(region, r.dryRun)
+					semaphore.Notify()
+
+					v.report.Resources = resources
+
+					if skipErr := (*skipError)(nil); errors.As(err, &skipErr) {
+						v.status = sweeperStatusSkipped
+						v.report.Reason = skipErr.reason
+					} else if err != nil {
+						log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+						v.status = sweeperStatusFailed
+						v.report.Reason = err.Error()
+					} else {
+						v.status = sweeperStatusSwept
+					}
+				}
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			results[name] = v
+		}()
+	}
+
+	wg.Wait()
+
+	log.Printf("[DEBUG] Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	report := &RegionReport{
+		Swept:   make([]SweeperReport, 0),
+		Skipped: make([]SweeperReport, 0),
+		Failed:  make([]SweeperReport, 0),
+	}
+
+	for _, v := range results {
+		switch v.status {
+		case sweeperStatusSwept:
+			report.Swept = append(report.Swept, v.report)
+		case sweeperStatusSkipped:
+			report.Skipped = append(report.Skipped, v.report)
+		case sweeperStatusFailed:
+			report.Failed = append(report.Failed, v.report)
+		}
+	}
+
+	report.sort()
+
+	return report
+}
+
+// service returns the name of the service package implementing the specified resource type.
+// Resource types that cannot be mapped to a service package have their concurrency limited individually.
+func (r *runner) service(name string) string {
+	if v, ok := r.services[name]; ok {
+		return v
+	}
+
+	return name
+}
+
+// resourceTypeServices maps the resource type names implemented by the registered service packages to the service package names.
+func resourceTypeServices(ctx context.Context) map[string]string {
+	services := make(map[string]string)
+
+	for _, sp := range ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.SDKResources(ctx) {
+			services[v.TypeName] = servicePackageName
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			services[response.TypeName] = servicePackageName
+		}
+	}
+
+	return services
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise the tests.
+// It replaces resource.TestMain, adding the -sweep-dry-run and -sweep-report flags.
+//
+// Sweepers for each Region run concurrently and in dependency order.
+// The number of sweepers running concurrently for a service package is limited by the
+// TF_AWS_SWEEP_SERVICE_CONCURRENCY environment variable.
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	regions := flagString("sweep")
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	ctx := context.Background()
+
+	r, err := newRunner(sweepers, flagString("sweep-run"), resourceTypeServices(ctx), *flagSweepDryRun)
+	if err != nil {
+		log.Printf("[ERROR] Error building Sweeper dependency graph: %s", err)
+		os.Exit(1)
+	}
+
+	if len(r.notDryRunnable) > 0 {
+		log.Printf("[WARN] Dry run not supported, not running Sweepers: %s", strings.Join(r.notDryRunnable, ", "))
+	}
+
+	report := r.run(strings.Split(regions, ","))
+
+	report.log()
+
+	if path := *flagSweepReport; path != "" {
+		if err := report.write(path); err != nil {
+			log.Printf("[ERROR] Error writing Sweeper report: %s", err)
+			os.Exit(1)
+		}
+	}
+
+	if report.hasFailures() && !flagBool("sweep-allow-failures") {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagString returns the value of a string flag defined by the terraform-plugin-testing resource package.
+func flagString(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// flagBool returns the value of a boolean flag defined by the terraform-plugin-testing resource package.
+func flagBool(name string) bool {
+	return flagString(name) == "true"
+}
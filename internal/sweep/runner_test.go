@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRunner(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lock  sync.Mutex
+		order []string
+	)
+	f := func(name string, err error) func(string) error {
+		return func(region string) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			order = append(order, name)
+
+			return err
+		}
+	}
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", f: f("aws_a", nil)},
+		"aws_b": {name: "aws_b", f: f("aws_b", nil), dependencies: []string{"aws_a"}},
+		"aws_c": {name: "aws_c", f: f("aws_c", errors.New("failed")), dependencies: []string{"aws_a"}},
+		"aws_d": {name: "aws_d", f: f("aws_d", nil), dependencies: []string{"aws_b", "aws_c"}},
+		"aws_e": {name: "aws_e", f: f("aws_e", nil), dependencies: []string{"aws_d"}},
+		"aws_f": {name: "aws_f", f: f("aws_f", nil), dependencies: []string{"aws_z"}},
+	}
+
+	r, err := newRunner(sweepers, "", nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := r.run([]string{"us-west-2"})
+	want := &Report{
+		Regions: map[string]*RegionReport{
+			"us-west-2": {
+				Swept: []SweeperReport{
+					{Name: "aws_a"},
+					{Name: "aws_b"},
+				},
+				Skipped: []SweeperReport{
+					{Name: "aws_d", Reason: "dependencies failed: aws_c"},
+					{Name: "aws_e", Reason: "dependencies failed: aws_d"},
+				},
+				Failed: []SweeperReport{
+					{Name: "aws_c", Reason: "failed"},
+					{Name: "aws_f", Reason: "dependencies not found: aws_z"},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(order[0], "aws_a"); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+	if diff := cmp.Diff(order, []string{"aws_a", "aws_b", "aws_c"}, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestRunnerDryRun(t *testing.T) {
+	t.Parallel()
+
+	var ran atomic.Bool
+	f := func(string) error {
+		ran.Store(true)
+
+		return errors.New("should not run")
+	}
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", f: f},
+		"aws_b": {name: "aws_b", f: f, dependencies: []string{"aws_a"}},
+		"aws_c": {name: "aws_c", f: f},
+	}
+
+	r, err := newRunner(sweepers, "aws_b", nil, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := r.run([]string{"us-west-2", "us-east-1"})
+	want := &Report{
+		DryRun:         true,
+		NotDryRunnable: []string{"aws_a", "aws_b"},
+		Regions: map[string]*RegionReport{
+			"us-west-2": {
+				Swept:   []SweeperReport{},
+				Skipped: []SweeperReport{},
+				Failed:  []SweeperReport{},
+			},
+			"us-east-1": {
+				Swept:   []SweeperReport{},
+				Skipped: []SweeperReport{},
+				Failed:  []SweeperReport{},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if ran.Load() {
+		t.Error("expected sweepers not to run")
+	}
+}
+
+func TestSweeperRunDryRunNotRegistered(t *testing.T) {
+	t.Parallel()
+
+	s := &sweeper{name: "aws_a", f: func(string) error { return errors.New("should not run") }}
+
+	_, err := s.run("us-west-2", true)
+
+	if skipErr := (*skipError)(nil); !errors.As(err, &skipErr) {
+		t.Errorf("expected skip error, got %v", err)
+	}
+}
+
+func TestSweeperSweepSkipped(t *testing.T) {
+	t.Parallel()
+
+	s := &sweeper{name: "aws_a", f: func(string) error { return &skipError{reason: "unsupported region"} }}
+
+	if err := s.sweep("us-west-2"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	s = &sweeper{name: "aws_b", f: func(string) error { return errors.New("failed") }}
+
+	if err := s.sweep("us-west-2"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRunnerFilter(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a"},
+		"aws_b": {name: "aws_b", dependencies: []string{"aws_a"}},
+		"aws_c": {name: "aws_c"},
+	}
+
+	r, err := newRunner(sweepers, "AWS_B", nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := r.graph.Len(), 2; got != want {
+		t.Errorf("incorrect length. Expected: %d, got: %d", want, got)
+	}
+	if r.graph.HasNode("aws_c") {
+		t.Errorf("expected graph not to contain aws_c")
+	}
+}
+
+func TestRunnerCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", dependencies: []string{"aws_b"}},
+		"aws_b": {name: "aws_b", dependencies: []string{"aws_a"}},
+	}
+
+	if _, err := newRunner(sweepers, "", nil, false); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
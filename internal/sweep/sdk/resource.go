@@ -39,6 +39,11 @@ func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *c
 	}
 }
 
+// String returns the ID of the resource to be swept.
+func (sr *sweepResource) String() string {
+	return sr.d.Id()
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	ctx = tflog.SetField(ctx, "id", sr.d.Id())
 
@@ -89,6 +94,37 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ImportID returns the ID used to import the resource.
+func (sr *sweepResource) ImportID() string {
+	return sr.d.Id()
+}
+
+// ReadAttributes reads the resource and returns the values of the specified top-level string attributes.
+func (sr *sweepResource) ReadAttributes(ctx context.Context, names ...string) (map[string]string, bool, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, false, err
+	}
+
+	if sr.d.Id() == "" {
+		return nil, false, nil
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range names {
+		if _, ok := sr.resource.SchemaMap()[name]; !ok {
+			continue
+		}
+
+		if v, ok := sr.d.Get(name).(string); ok && v != "" {
+			attributes[name] = v
+		}
+	}
+
+	return attributes, true, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -13,6 +13,8 @@ import (
 type InContext struct {
 	DefaultConfig *DefaultConfig
 	IgnoreConfig  *IgnoreConfig
+	// ResourceType is the type name of the resource or data source, e.g. `aws_vpc`, used to select resource type default tags.
+	ResourceType string
 	// TagsIn holds tags specified in configuration. Typically this field includes any default tags and excludes system tags.
 	TagsIn option.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
@@ -20,10 +22,11 @@ type InContext struct {
 }
 
 // NewContext returns a Context enhanced with tagging information.
-func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
+func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resourceType string) context.Context {
 	v := InContext{
 		DefaultConfig: defaultConfig,
 		IgnoreConfig:  ignoreConfig,
+		ResourceType:  resourceType,
 		TagsIn:        option.None[KeyValueTags](),
 		TagsOut:       option.None[KeyValueTags](),
 	}
@@ -33,6 +33,9 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ResourceTypeTags contains additional tags to default across resources of matching types.
+	// Later entries take precedence over earlier ones.
+	ResourceTypeTags []ResourceTypeDefaultConfig
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,15 +59,36 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
-// MergeTags returns the result of keyvaluetags.Merge() on the given
-// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// TagsForResourceType returns the tags to default across resources of the specified type:
+// DefaultConfig.Tags merged with the tags of any matching ResourceTypeTags.
+// Resource type tags override the value of any default tag with a matching key.
+func (dc *DefaultConfig) TagsForResourceType(resourceType string) KeyValueTags {
+	if dc == nil {
+		return nil
+	}
+
+	tags := dc.Tags
+
+	for _, v := range dc.ResourceTypeTags {
+		if v.Matches(resourceType) {
+			tags = tags.Merge(v.Tags)
+		}
+	}
+
+	return tags
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the default tags
+// for the specified resource type with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
-func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags, resourceType string) KeyValueTags {
+	defaultTags := dc.TagsForResourceType(resourceType)
+
+	if defaultTags == nil {
 		return tags
 	}
 
-	return dc.Tags.Merge(tags)
+	return defaultTags.Merge(tags)
 }
 
 // TagsEqual returns true if the given configuration's Tags
@@ -503,15 +527,17 @@ func (tags KeyValueTags) Hash() int {
 // however, if all tags present in the DefaultConfig object are equivalent to those
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
-func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
-	if dc == nil || dc.Tags == nil {
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig, resourceType string) KeyValueTags {
+	defaultTags := dc.TagsForResourceType(resourceType)
+
+	if defaultTags == nil {
 		return tags
 	}
 
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		if defaultVal, ok := defaultTags[k]; !ok || !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
@@ -768,9 +794,10 @@ type configTag struct {
 }
 
 // ResolveDuplicates resolves differences between incoming tags, defaultTags, and ignoreConfig
-func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, d schemaResourceData) KeyValueTags {
+func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, d schemaResourceData, resourceType string) KeyValueTags {
 	// remove default config.
-	t := tags.RemoveDefaultConfig(defaultConfig)
+	t := tags.RemoveDefaultConfig(defaultConfig, resourceType)
+	defaultTags := defaultConfig.TagsForResourceType(resourceType)
 
 	cf := d.GetRawConfig()
 	configExists := !cf.IsNull() && cf.IsKnown()
@@ -812,7 +839,7 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v.value {
+				if val, ok := defaultTags[k]; ok && val.ValueString() == v.value {
 					// config does not exist during a refresh.
 					// set duplicate values from other sources for refresh diff calculation
 					if !configExists {
@@ -831,9 +858,10 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 }
 
 // ResolveDuplicatesFramework resolves differences between incoming tags, defaultTags, and ignoreConfig
-func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resp *resource.ReadResponse, diags fwdiag.Diagnostics) KeyValueTags {
+func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resp *resource.ReadResponse, diags fwdiag.Diagnostics, resourceType string) KeyValueTags {
 	// remove default config.
-	t := tags.RemoveDefaultConfig(defaultConfig)
+	t := tags.RemoveDefaultConfig(defaultConfig, resourceType)
+	defaultTags := defaultConfig.TagsForResourceType(resourceType)
 
 	var tagsAll types.Map
 	diags.Append(resp.State.GetAttribute(ctx, path.Root("tags"), &tagsAll)...)
@@ -859,7 +887,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultTags[k]; ok && val.ValueString() == s {
 					result[k] = s
 				}
 			}
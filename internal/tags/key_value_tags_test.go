@@ -65,6 +65,7 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 		name          string
 		tags          KeyValueTags
 		defaultConfig *DefaultConfig
+		resourceType  string
 		want          map[string]string
 	}{
 		{
@@ -190,6 +191,86 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "resource type tags matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+					"key3": "value3",
+				}),
+				ResourceTypeTags: []ResourceTypeDefaultConfig{
+					{
+						ResourceType: "aws_db_*",
+						Tags: New(ctx, map[string]string{
+							"key3": "value3-db",
+							"key4": "value4",
+						}),
+					},
+					{
+						ResourceType: "aws_security_group_rule",
+						Tags: New(ctx, map[string]string{
+							"key5": "value5",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_db_instance",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3-db",
+				"key4": "value4",
+			},
+		},
+		{
+			name: "resource type tags overridden",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				ResourceTypeTags: []ResourceTypeDefaultConfig{
+					{
+						ResourceType: "aws_ebs_volume",
+						Tags: New(ctx, map[string]string{
+							"key1": "value1-ebs",
+							"key2": "value2",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_ebs_volume",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "resource type tags none matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+				ResourceTypeTags: []ResourceTypeDefaultConfig{
+					{
+						ResourceType: "aws_db_*",
+						Tags: New(ctx, map[string]string{
+							"key3": "value3",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_security_group_rule",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -197,7 +278,7 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.defaultConfig.MergeTags(testCase.tags)
+			got := testCase.defaultConfig.MergeTags(testCase.tags, testCase.resourceType)
 			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
 		})
 	}
@@ -2276,7 +2357,7 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig, "")
 
 			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
 		})
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// RequiredTagsModeWarn reports resources that violate the required tags configuration as plan warnings.
+	RequiredTagsModeWarn = "warn"
+	// RequiredTagsModeError reports resources that violate the required tags configuration as plan errors.
+	RequiredTagsModeError = "error"
+)
+
+// RequiredTagsModes returns the valid values for the required tags mode.
+func RequiredTagsModes() []string {
+	return []string{
+		RequiredTagsModeWarn,
+		RequiredTagsModeError,
+	}
+}
+
+// RequiredConfig contains tags that are required on all taggable resources.
+type RequiredConfig struct {
+	Mode string
+	// Tags maps each required tag key to the patterns of its allowed values.
+	// A tag with no patterns may have any value.
+	Tags map[string][]*regexp.Regexp
+}
+
+// IsError returns whether violations are errors rather than warnings.
+func (rc *RequiredConfig) IsError() bool {
+	return rc != nil && rc.Mode == RequiredTagsModeError
+}
+
+// Validate returns an error describing any required tags missing from the given tags
+// and any required tags with a value that does not match one of the allowed value patterns.
+func (rc *RequiredConfig) Validate(tags KeyValueTags) error {
+	if rc == nil || len(rc.Tags) == 0 {
+		return nil
+	}
+
+	var missing, invalid []string
+
+	for key, patterns := range rc.Tags {
+		v, ok := tags[key]
+		if !ok || v == nil || v.Value == nil {
+			missing = append(missing, key)
+			continue
+		}
+
+		if len(patterns) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(*v.Value)
+		}) {
+			invalid = append(invalid, fmt.Sprintf("%s (%q)", key, *v.Value))
+		}
+	}
+
+	slices.Sort(missing)
+	slices.Sort(invalid)
+
+	var errs []error
+
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Errorf("missing required tags: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(invalid) > 0 {
+		errs = append(errs, fmt.Errorf("required tags with values not allowed by the provider's required_tags configuration: %s", strings.Join(invalid, ", ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+// RequiredTagsSummary is the summary of diagnostics reporting required tags violations.
+const RequiredTagsSummary = "Resource does not comply with required tags"
+
+// RequiredTagsDetail returns the detail of a diagnostic reporting a required tags violation for a resource of the given type and ID.
+// The ID of a resource being created is empty.
+func RequiredTagsDetail(typeName, id string, err error) string {
+	resource := typeName + " resource"
+	if id != "" {
+		resource = fmt.Sprintf("%s resource (%s)", typeName, id)
+	}
+
+	return fmt.Sprintf("The planned %s for this %s does not comply with the provider's required_tags configuration:\n\n%s", names.AttrTagsAll, resource, err)
+}
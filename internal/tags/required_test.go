@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestRequiredConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		wantErr        string
+	}{
+		{
+			name:           "nil config",
+			requiredConfig: nil,
+			tags:           New(ctx, map[string]string{}),
+		},
+		{
+			name: "all present",
+			requiredConfig: &RequiredConfig{
+				Tags: map[string][]*regexp.Regexp{
+					"CostCenter": nil,
+					"Owner":      nil,
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "team-a",
+				"Other":      "value",
+			}),
+		},
+		{
+			name: "missing",
+			requiredConfig: &RequiredConfig{
+				Tags: map[string][]*regexp.Regexp{
+					"CostCenter": nil,
+					"DataClass":  nil,
+					"Owner":      nil,
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"Owner": "team-a",
+			}),
+			wantErr: "missing required tags: CostCenter, DataClass",
+		},
+		{
+			name: "allowed values",
+			requiredConfig: &RequiredConfig{
+				Tags: map[string][]*regexp.Regexp{
+					"DataClass": {regexache.MustCompile(`^(?:public)$`), regexache.MustCompile(`^(?:confidential)$`)},
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"DataClass": "confidential",
+			}),
+		},
+		{
+			name: "value not allowed",
+			requiredConfig: &RequiredConfig{
+				Tags: map[string][]*regexp.Regexp{
+					"CostCenter": {regexache.MustCompile(`^(?:\d{4})$`)},
+					"Owner":      nil,
+				},
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "abc",
+			}),
+			wantErr: "missing required tags: Owner\nrequired tags with values not allowed by the provider's required_tags configuration: CostCenter (\"abc\")",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.requiredConfig.Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.wantErr)
+			} else if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("got error %q, want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequiredTagsDetail(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("missing required tags: Owner")
+
+	testCases := []struct {
+		name     string
+		typeName string
+		id       string
+		want     string
+	}{
+		{
+			name:     "new resource",
+			typeName: "aws_vpc",
+			want:     "The planned tags_all for this aws_vpc resource does not comply with the provider's required_tags configuration:\n\nmissing required tags: Owner",
+		},
+		{
+			name:     "existing resource",
+			typeName: "aws_vpc",
+			id:       "vpc-12345678",
+			want:     "The planned tags_all for this aws_vpc resource (vpc-12345678) does not comply with the provider's required_tags configuration:\n\nmissing required tags: Owner",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := RequiredTagsDetail(testCase.typeName, testCase.id, err); got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
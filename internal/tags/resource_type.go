@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+)
+
+// ResourceTypeDefaultConfig contains tags to default across resources whose type name matches a pattern.
+type ResourceTypeDefaultConfig struct {
+	// ResourceType is a resource type name, e.g. `aws_db_instance`, or a glob pattern, e.g. `aws_db_*`.
+	ResourceType string
+	Tags         KeyValueTags
+}
+
+// Matches returns whether the configuration applies to the specified resource type name.
+func (rtc ResourceTypeDefaultConfig) Matches(resourceType string) bool {
+	if resourceType == "" {
+		return false
+	}
+
+	matched, err := path.Match(rtc.ResourceType, resourceType)
+
+	return err == nil && matched
+}
@@ -17,36 +17,49 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIAMActions represents the IAM actions that a resource requires for each operation.
+// They are used to check the caller's permissions at plan time.
+type ServicePackageResourceIAMActions struct {
+	Create []string
+	Update []string
+	Delete []string
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
-	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory   func(context.Context) (datasource.DataSourceWithConfigure, error)
+	Name      string
+	Tags      *ServicePackageResourceTags
+	Cacheable bool // Reads may be served from the provider's data source cache.
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory    func(context.Context) (resource.ResourceWithConfigure, error)
+	Name       string
+	Tags       *ServicePackageResourceTags
+	IAMActions *ServicePackageResourceIAMActions
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory   func() *schema.Resource
+	TypeName  string
+	Name      string
+	Tags      *ServicePackageResourceTags
+	Cacheable bool // Reads may be served from the provider's data source cache.
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory             func() *schema.Resource
+	TypeName            string
+	Name                string
+	Tags                *ServicePackageResourceTags
+	IAMActions          *ServicePackageResourceIAMActions
+	IAMPolicyAttributes []string // Top-level attributes whose values are IAM policy documents.
 }
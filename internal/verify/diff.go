@@ -26,9 +26,14 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
+	var resourceType string
+	if tagsInContext, ok := tftags.FromContext(ctx); ok {
+		resourceType = tagsInContext.ResourceType
+	}
+
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	allTags := defaultTagsConfig.MergeTags(resourceTags, resourceType).IgnoreConfig(ignoreTagsConfig)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when there is a known diff (excluding an empty map)
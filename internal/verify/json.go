@@ -15,6 +15,7 @@ import (
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
@@ -24,29 +25,10 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return PolicyStringsEquivalent(old, new)
 }
 
+// PolicyStringsEquivalent returns whether two JSON strings representing IAM policies are semantically equivalent.
+// See iampolicy.Equivalent.
 func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
-
-	return equivalent
+	return iampolicy.Equivalent(s1, s2)
 }
 
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
@@ -139,14 +121,13 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
-
-	if err != nil {
-		return "", err
+	if iampolicy.Equivalent(old, new) {
+		return old, nil
 	}
 
-	if equivalent {
-		return old, nil
+	// Return any error parsing the policies.
+	if _, err := awspolicy.PoliciesAreEquivalent(old, new); err != nil {
+		return "", err
 	}
 
 	return new, nil
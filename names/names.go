@@ -193,6 +193,32 @@ func PartitionForRegion(region string) string {
 	}
 }
 
+// IsGlobalService returns whether the specified service package's resources are global,
+// i.e. they are not scoped to an AWS Region.
+func IsGlobalService(service string) bool {
+	switch service {
+	case Account,
+		Budgets,
+		CE,
+		CloudFront,
+		CostOptimizationHub,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		NetworkManager,
+		Organizations,
+		Route53,
+		Route53Domains,
+		Route53RecoveryControlConfig,
+		Route53RecoveryReadiness,
+		Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
 // ReverseDNS switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDNS(hostname string) string {
 	parts := strings.Split(hostname, ".")
@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"errors"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -16,12 +18,22 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	model         string
+	operations    resource.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if model != "" {
+			if v1 || pluginSDKV2 {
+				return errors.New("generating from an AWS API model is only supported for AWS Go SDK v2 and the Terraform Plugin Framework")
+			}
+
+			return resource.CreateFromModel(name, snakeName, model, operations, force, includeTags)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +47,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVarP(&model, "model", "m", "", "generate CRUD handlers, finder, waiters and sweeper from an AWS API model (Smithy JSON, e.g. aws-sdk-go-v2/codegen/sdk-codegen/aws-models/<service>.json)")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-operation", "", "with --model, name of the create operation (default Create<name>)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-operation", "", "with --model, name of the read operation (default Get<name> or Describe<name>)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-operation", "", "with --model, name of the update operation (default Update<name>, if present)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-operation", "", "with --model, name of the delete operation (default Delete<name>)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-operation", "", "with --model, name of the list operation used by the sweeper (default List<name>s, if present)")
 }
@@ -0,0 +1,1030 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Operations names the AWS API operations used to manage a resource.
+// Unset operations are given default names derived from the resource name
+// (e.g. CreateCluster, GetCluster or DescribeCluster, UpdateCluster, DeleteCluster and ListClusters).
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// ModelData is the template data derived from an AWS API model.
+type ModelData struct {
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	// IDMember is the name of the Read operation's input member identifying the resource.
+	IDMember string
+	// UpdateIDMember and DeleteIDMember are the names of the Update and Delete operations' input members identifying the resource.
+	UpdateIDMember string
+	DeleteIDMember string
+	// IDFromOutput is the path, relative to the Create operation's output, of the resource's identifier.
+	// If empty, the identifier is taken from the Create operation's input.
+	IDFromOutput string
+	// ClientToken is the name of the Create operation's idempotency token input member, if any.
+	ClientToken string
+
+	// FindMember is the name of the Read operation's output member containing the resource.
+	// If empty, the output itself describes the resource.
+	FindMember string
+	// FindType is the Go type returned by the resource's finder and FindValueType the type it points to.
+	FindType      string
+	FindValueType string
+	// NotFoundException is the Go type of the error returned when the resource does not exist.
+	NotFoundException string
+
+	Status *StatusData
+	List   *ListData
+
+	Root    *ObjectData
+	Objects []*ObjectData
+
+	// Updatable contains the Go names of the fields that can be changed in-place.
+	Updatable []string
+	// TagsIdentifier is the name of the attribute used to identify the resource when tagging.
+	TagsIdentifier string
+	// NameAttribute is the name of the required attribute set to a random name in acceptance tests, if any.
+	NameAttribute string
+
+	StdImports []string
+	Imports    []string
+}
+
+// StatusData describes the resource's status member and the values used by its waiters.
+type StatusData struct {
+	Member        string
+	CreatePending []string
+	CreateTarget  []string
+	UpdatePending []string
+	UpdateTarget  []string
+	DeletePending []string
+}
+
+// ListData describes the operation used to list resources in the sweeper.
+type ListData struct {
+	Operation string
+	Paginated bool
+	Items     string
+	IDMember  string
+}
+
+// ObjectData describes a model struct and the schema attributes and blocks mapped to its fields.
+type ObjectData struct {
+	Name        string
+	Fields      []*FieldData
+	Attributes  []*FieldData
+	Blocks      []*FieldData
+	Unsupported []string
+}
+
+// FieldData describes a single model struct field.
+type FieldData struct {
+	GoName          string
+	TFName          string
+	ModelType       string
+	SchemaType      string
+	CustomType      string
+	ElementType     string
+	Object          *ObjectData
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	Sensitive       bool
+	MaxOne          bool
+}
+
+// PlanModifierType returns the planmodifier interface for the field's schema type (e.g. planmodifier.String).
+func (f *FieldData) PlanModifierType() string {
+	return "planmodifier." + f.SchemaType
+}
+
+// PlanModifierPackage returns the name of the plan modifier package for the field's schema type (e.g. stringplanmodifier).
+func (f *FieldData) PlanModifierPackage() string {
+	return strings.ToLower(f.SchemaType) + "planmodifier"
+}
+
+const (
+	smithyTraitEnum             = "smithy.api#enum"
+	smithyTraitEnumValue        = "smithy.api#enumValue"
+	smithyTraitIdempotencyToken = "smithy.api#idempotencyToken"
+	smithyTraitPaginated        = "smithy.api#paginated"
+	smithyTraitRequired         = "smithy.api#required"
+	smithyTraitSensitive        = "smithy.api#sensitive"
+	smithyTraitUniqueItems      = "smithy.api#uniqueItems"
+)
+
+// Schema types of the fields rendered using the provider's common attributes.
+const (
+	schemaTypeID      = "ID"
+	schemaTypeTags    = "Tags"
+	schemaTypeTagsAll = "TagsAll"
+)
+
+// smithyModel is an AWS API model in Smithy JSON AST form,
+// as found in the aws-sdk-go-v2 repository's codegen/sdk-codegen/aws-models directory.
+type smithyModel struct {
+	Shapes map[string]*smithyShape `json:"shapes"`
+}
+
+type smithyShape struct {
+	Type    string                     `json:"type"`
+	Members map[string]*smithyMember   `json:"members"`
+	Member  *smithyMember              `json:"member"`
+	Key     *smithyMember              `json:"key"`
+	Value   *smithyMember              `json:"value"`
+	Input   *smithyMember              `json:"input"`
+	Output  *smithyMember              `json:"output"`
+	Errors  []*smithyMember            `json:"errors"`
+	Traits  map[string]json.RawMessage `json:"traits"`
+}
+
+type smithyMember struct {
+	Target string                     `json:"target"`
+	Traits map[string]json.RawMessage `json:"traits"`
+}
+
+func (m *smithyMember) hasTrait(name string) bool {
+	_, ok := m.Traits[name]
+	return ok
+}
+
+func loadModel(filename string) (*smithyModel, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS API model (%s): %w", filename, err)
+	}
+
+	return parseModel(b)
+}
+
+func parseModel(b []byte) (*smithyModel, error) {
+	var m smithyModel
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("parsing AWS API model: %w", err)
+	}
+
+	if len(m.Shapes) == 0 {
+		return nil, fmt.Errorf("parsing AWS API model: no shapes")
+	}
+
+	return &m, nil
+}
+
+// shapeName returns the name part of a shape ID (e.g. "Cluster" for "com.amazonaws.docdbelastic#Cluster").
+func shapeName(id string) string {
+	if _, name, ok := strings.Cut(id, "#"); ok {
+		return name
+	}
+
+	return id
+}
+
+// lookup returns the shape with the specified ID, or nil if it is a prelude shape or does not exist.
+func (m *smithyModel) lookup(id string) *smithyShape {
+	return m.Shapes[id]
+}
+
+// operation returns the operation with the specified name.
+func (m *smithyModel) operation(name string) (*smithyShape, error) {
+	for id, shape := range m.Shapes {
+		if shape.Type == "operation" && shapeName(id) == name {
+			return shape, nil
+		}
+	}
+
+	return nil, fmt.Errorf("operation (%s) not found in AWS API model", name)
+}
+
+func (m *smithyModel) hasOperation(name string) bool {
+	_, err := m.operation(name)
+	return err == nil
+}
+
+// structure returns the structure targeted by the specified member, or nil.
+func (m *smithyModel) structure(member *smithyMember) *smithyShape {
+	if member == nil {
+		return nil
+	}
+
+	if shape := m.lookup(member.Target); shape != nil && shape.Type == "structure" {
+		return shape
+	}
+
+	return nil
+}
+
+// defaultOperations fills in the names of any unset operations.
+// Optional operations (Update and List) are only defaulted if present in the model.
+func (m *smithyModel) defaultOperations(resName string, ops Operations) Operations {
+	if ops.Create == "" {
+		ops.Create = "Create" + resName
+	}
+	if ops.Read == "" {
+		ops.Read = "Get" + resName
+		if !m.hasOperation(ops.Read) && m.hasOperation("Describe"+resName) {
+			ops.Read = "Describe" + resName
+		}
+	}
+	if ops.Update == "" && m.hasOperation("Update"+resName) {
+		ops.Update = "Update" + resName
+	}
+	if ops.Delete == "" {
+		ops.Delete = "Delete" + resName
+	}
+	if ops.List == "" {
+		for _, v := range []string{"List" + resName + "s", "List" + resName + "es", "List" + strings.TrimSuffix(resName, "y") + "ies"} {
+			if m.hasOperation(v) {
+				ops.List = v
+				break
+			}
+		}
+	}
+
+	return ops
+}
+
+// newModelData derives the template data for a resource from an AWS API model.
+func newModelData(m *smithyModel, resName, servicePackage string, ops Operations, tags bool) (*ModelData, error) {
+	ops = m.defaultOperations(resName, ops)
+
+	create, err := m.operation(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+	read, err := m.operation(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+	delete, err := m.operation(ops.Delete)
+	if err != nil {
+		return nil, err
+	}
+	var update *smithyShape
+	if ops.Update != "" {
+		if update, err = m.operation(ops.Update); err != nil {
+			return nil, err
+		}
+	}
+
+	createInput, readInput, readOutput := m.structure(create.Input), m.structure(read.Input), m.structure(read.Output)
+	if createInput == nil || readInput == nil || readOutput == nil {
+		return nil, fmt.Errorf("operations (%s, %s) must have input and output structures", ops.Create, ops.Read)
+	}
+
+	data := &ModelData{
+		CreateOperation: ops.Create,
+		ReadOperation:   ops.Read,
+		UpdateOperation: ops.Update,
+		DeleteOperation: ops.Delete,
+	}
+
+	// The resource is identified by the Read operation's first required input member.
+	for _, name := range sortedMemberNames(readInput) {
+		if readInput.Members[name].hasTrait(smithyTraitRequired) {
+			data.IDMember = goName(name)
+			break
+		}
+	}
+	if data.IDMember == "" {
+		return nil, fmt.Errorf("operation (%s) has no required input members", ops.Read)
+	}
+
+	// The resource is described either by a single structure member of the Read operation's output or by the output itself.
+	resourceShape := readOutput
+	data.FindValueType = fmt.Sprintf("%s.%sOutput", servicePackage, ops.Read)
+	if len(readOutput.Members) == 1 {
+		for name, member := range readOutput.Members {
+			if shape := m.structure(member); shape != nil {
+				resourceShape = shape
+				data.FindMember = goName(name)
+				data.FindValueType = "awstypes." + goName(shapeName(member.Target))
+			}
+		}
+	}
+	data.FindType = "*" + data.FindValueType
+
+	data.NotFoundException = "ResourceNotFoundException"
+	for _, v := range read.Errors {
+		if name := shapeName(v.Target); strings.Contains(name, "NotFound") {
+			data.NotFoundException = goName(name)
+			break
+		}
+	}
+
+	for _, name := range sortedMemberNames(createInput) {
+		if createInput.Members[name].hasTrait(smithyTraitIdempotencyToken) {
+			data.ClientToken = goName(name)
+		}
+	}
+
+	data.IDFromOutput = idFromOutput(m, m.structure(create.Output), data.IDMember)
+	if data.IDFromOutput == "" && !hasMember(createInput, data.IDMember) {
+		return nil, fmt.Errorf("resource identifier (%s) found in neither operation (%s) input nor output", data.IDMember, ops.Create)
+	}
+
+	var updateInput *smithyShape
+	if update != nil {
+		updateInput = m.structure(update.Input)
+	}
+
+	b := &modelBuilder{
+		model:    m,
+		objects:  make(map[string]*ObjectData),
+		visiting: make(map[string]bool),
+	}
+	data.Status = statusData(m, resourceShape)
+	data.Root = b.root(resName, createInput, resourceShape, updateInput, data, tags)
+	data.Objects = b.order
+
+	if updateInput != nil {
+		for _, v := range data.Root.Fields {
+			if (v.Required || v.Optional) && !v.RequiresReplace {
+				data.Updatable = append(data.Updatable, v.GoName)
+			}
+		}
+	}
+
+	for _, v := range data.Root.Attributes {
+		if v.Required && v.SchemaType == "String" && v.CustomType == "" && (v.TFName == "name" || strings.HasSuffix(v.TFName, "_name")) {
+			data.NameAttribute = v.TFName
+			break
+		}
+	}
+
+	data.DeleteIDMember = inputIDMember(m, delete, data.IDMember)
+	if update != nil {
+		data.UpdateIDMember = inputIDMember(m, update, data.IDMember)
+	}
+
+	if ops.List != "" {
+		list, err := m.operation(ops.List)
+		if err != nil {
+			return nil, err
+		}
+		data.List = listData(m, ops.List, list, data.IDMember)
+	}
+
+	if tags {
+		for _, v := range data.Root.Fields {
+			if v.TFName == "arn" || strings.HasSuffix(v.TFName, "_arn") && v.Computed {
+				data.TagsIdentifier = v.TFName
+				break
+			}
+		}
+		if data.TagsIdentifier == "" {
+			data.TagsIdentifier = "id"
+		}
+	}
+
+	data.StdImports, data.Imports = imports(data, servicePackage, tags)
+
+	return data, nil
+}
+
+// idFromOutput returns the path to the resource identifier in the Create operation's output, or "".
+func idFromOutput(m *smithyModel, output *smithyShape, idMember string) string {
+	if output == nil {
+		return ""
+	}
+
+	for _, name := range sortedMemberNames(output) {
+		if goName(name) == idMember {
+			return goName(name)
+		}
+	}
+
+	for _, name := range sortedMemberNames(output) {
+		if shape := m.structure(output.Members[name]); shape != nil {
+			if path := idFromOutput(m, shape, idMember); path != "" {
+				return goName(name) + "." + path
+			}
+		}
+	}
+
+	return ""
+}
+
+// inputIDMember returns the name of the operation's input member identifying the resource:
+// the member with the same name as the Read operation's, otherwise the first required member.
+func inputIDMember(m *smithyModel, operation *smithyShape, idMember string) string {
+	input := m.structure(operation.Input)
+	if input == nil {
+		return idMember
+	}
+
+	if hasMember(input, idMember) {
+		return idMember
+	}
+
+	for _, name := range sortedMemberNames(input) {
+		if input.Members[name].hasTrait(smithyTraitRequired) {
+			return goName(name)
+		}
+	}
+
+	return idMember
+}
+
+func hasMember(shape *smithyShape, member string) bool {
+	for name := range shape.Members {
+		if goName(name) == member {
+			return true
+		}
+	}
+
+	return false
+}
+
+// statusData returns the resource's status member and waiter values, or nil if the resource has no status.
+func statusData(m *smithyModel, shape *smithyShape) *StatusData {
+	for _, name := range sortedMemberNames(shape) {
+		if goName := goName(name); !strings.HasSuffix(goName, "Status") && !strings.HasSuffix(goName, "State") {
+			continue
+		}
+
+		member := shape.Members[name]
+		values := enumConstants(m, member.Target)
+		if len(values) == 0 {
+			continue
+		}
+
+		status := &StatusData{
+			Member: goName(name),
+		}
+		for _, v := range values {
+			switch u := strings.ToUpper(v.value); {
+			case strings.Contains(u, "FAIL"):
+			case strings.Contains(u, "DELET"):
+				status.DeletePending = append(status.DeletePending, v.constant)
+			case strings.Contains(u, "UPDAT"), strings.Contains(u, "MODIFY"):
+				status.UpdatePending = append(status.UpdatePending, v.constant)
+			case strings.Contains(u, "CREAT"), strings.Contains(u, "PENDING"), strings.Contains(u, "PROVISION"), strings.Contains(u, "PROGRESS"):
+				status.CreatePending = append(status.CreatePending, v.constant)
+			case slices.Contains([]string{"ACTIVE", "AVAILABLE", "CREATED", "ENABLED", "READY", "RUNNING", "SUCCEEDED"}, u):
+				status.CreateTarget = append(status.CreateTarget, v.constant)
+			}
+		}
+		if len(status.CreateTarget) == 0 {
+			continue
+		}
+		status.UpdateTarget = status.CreateTarget
+
+		return status
+	}
+
+	return nil
+}
+
+type enumConstant struct {
+	value    string
+	constant string
+}
+
+// enumConstants returns the values of the enum targeted by a member, with their AWS SDK for Go v2 constant names.
+func enumConstants(m *smithyModel, target string) []enumConstant {
+	shape := m.lookup(target)
+	if shape == nil {
+		return nil
+	}
+
+	typeName := goName(shapeName(target))
+	var constants []enumConstant
+
+	switch shape.Type {
+	case "enum":
+		for _, name := range sortedMemberNames(shape) {
+			value := name
+			if raw, ok := shape.Members[name].Traits[smithyTraitEnumValue]; ok {
+				_ = json.Unmarshal(raw, &value)
+			}
+			constants = append(constants, enumConstant{value: value, constant: "awstypes." + typeName + enumConstantName(name)})
+		}
+	case "string":
+		raw, ok := shape.Traits[smithyTraitEnum]
+		if !ok {
+			return nil
+		}
+		var definitions []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &definitions); err != nil {
+			return nil
+		}
+		for _, v := range definitions {
+			name := v.Name
+			if name == "" {
+				name = v.Value
+			}
+			constants = append(constants, enumConstant{value: v.Value, constant: "awstypes." + typeName + enumConstantName(name)})
+		}
+	}
+
+	return constants
+}
+
+// listData describes the List operation's output, or returns nil if its items can't be determined.
+func listData(m *smithyModel, name string, list *smithyShape, idMember string) *ListData {
+	output := m.structure(list.Output)
+	if output == nil {
+		return nil
+	}
+
+	data := &ListData{
+		Operation: name,
+	}
+
+	if raw, ok := list.Traits[smithyTraitPaginated]; ok {
+		var paginated struct {
+			Items string `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &paginated); err == nil {
+			data.Paginated = true
+			data.Items = goName(paginated.Items)
+		}
+	}
+
+	var items *smithyShape
+	for _, name := range sortedMemberNames(output) {
+		shape := m.lookup(output.Members[name].Target)
+		if shape == nil || (shape.Type != "list" && shape.Type != "set") {
+			continue
+		}
+		if data.Items != "" && goName(name) != data.Items {
+			continue
+		}
+		if items = m.structure(shape.Member); items != nil {
+			data.Items = goName(name)
+			break
+		}
+	}
+	if items == nil {
+		return nil
+	}
+
+	// Prefer the member with the same name as the resource identifier, then any identifying member.
+	names := sortedMemberNames(items)
+	for _, name := range names {
+		if goName(name) == idMember {
+			data.IDMember = goName(name)
+			return data
+		}
+	}
+	for _, suffix := range []string{"Arn", "Id", "Name"} {
+		if strings.HasSuffix(idMember, suffix) {
+			for _, name := range names {
+				if strings.HasSuffix(goName(name), suffix) {
+					data.IDMember = goName(name)
+					return data
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+type modelBuilder struct {
+	model    *smithyModel
+	objects  map[string]*ObjectData
+	order    []*ObjectData
+	visiting map[string]bool
+}
+
+// root returns the resource's top-level model, the union of the Create operation's input and the resource shape.
+func (b *modelBuilder) root(resName string, createInput, resourceShape, updateInput *smithyShape, data *ModelData, tags bool) *ObjectData {
+	obj := &ObjectData{
+		Name: "resource" + resName + "Data",
+	}
+
+	members := make(map[string]*smithyMember)
+	for name, member := range resourceShape.Members {
+		members[name] = member
+	}
+	// Input members take precedence as they describe what can be configured.
+	for name, member := range createInput.Members {
+		members[name] = member
+	}
+
+	for _, name := range sortedKeys(members) {
+		member := members[name]
+		goName := goName(name)
+
+		if goName == data.ClientToken || isTagsMember(b.model, name, member) {
+			continue
+		}
+
+		_, inCreate := createInput.Members[name]
+		inUpdate := false
+		if updateInput != nil {
+			_, inUpdate = updateInput.Members[name]
+		}
+		_, inResource := resourceShape.Members[name]
+
+		field := &FieldData{
+			GoName:   goName,
+			TFName:   ToSnakeCase(goName, ""),
+			Required: inCreate && member.hasTrait(smithyTraitRequired),
+			Computed: !inCreate,
+		}
+		field.Optional = inCreate && !field.Required
+		// Optional arguments that are also returned by the API may be defaulted by the service.
+		if field.Optional && inResource {
+			field.Computed = true
+		}
+		field.RequiresReplace = inCreate && !inUpdate
+
+		if !b.field(obj, field, member, !inCreate) {
+			continue
+		}
+	}
+
+	id := &FieldData{GoName: "ID", TFName: "id", ModelType: "types.String", SchemaType: schemaTypeID}
+	obj.Fields = append(obj.Fields, id)
+	obj.Attributes = append(obj.Attributes, id)
+	// Only resources with a status have waiters.
+	if data.Status != nil {
+		obj.Fields = append(obj.Fields, &FieldData{GoName: "Timeouts", TFName: "timeouts", ModelType: "timeouts.Value"})
+	}
+	if tags {
+		tags, tagsAll := &FieldData{GoName: "Tags", TFName: "tags", ModelType: "types.Map", SchemaType: schemaTypeTags},
+			&FieldData{GoName: "TagsAll", TFName: "tags_all", ModelType: "types.Map", SchemaType: schemaTypeTagsAll}
+		obj.Fields = append(obj.Fields, tags, tagsAll)
+		obj.Attributes = append(obj.Attributes, tags, tagsAll)
+	}
+	sortFields(obj)
+
+	return obj
+}
+
+// object returns the model for a nested structure.
+func (b *modelBuilder) object(target string, computed bool) *ObjectData {
+	name := lowerFirst(goName(shapeName(target))) + "Data"
+
+	if obj, ok := b.objects[name]; ok {
+		return obj
+	}
+
+	shape := b.model.lookup(target)
+	obj := &ObjectData{
+		Name: name,
+	}
+	b.objects[name] = obj
+	b.order = append(b.order, obj)
+	b.visiting[target] = true
+	defer delete(b.visiting, target)
+
+	for _, name := range sortedMemberNames(shape) {
+		member := shape.Members[name]
+		goName := goName(name)
+
+		field := &FieldData{
+			GoName:   goName,
+			TFName:   ToSnakeCase(goName, ""),
+			Required: !computed && member.hasTrait(smithyTraitRequired),
+			Optional: !computed && !member.hasTrait(smithyTraitRequired),
+			Computed: computed,
+		}
+
+		b.field(obj, field, member, computed)
+	}
+	sortFields(obj)
+
+	return obj
+}
+
+// field sets the field's types from the member's target and adds it to the object.
+// It returns false if the member's type is not supported.
+func (b *modelBuilder) field(obj *ObjectData, field *FieldData, member *smithyMember, computed bool) bool {
+	target := member.Target
+	shape := b.model.lookup(target)
+
+	field.Sensitive = member.hasTrait(smithyTraitSensitive) || (shape != nil && hasShapeTrait(shape, smithyTraitSensitive))
+
+	unsupported := func() bool {
+		obj.Unsupported = append(obj.Unsupported, field.GoName)
+		return false
+	}
+
+	if kind, ok := scalarKind(target, shape); ok {
+		switch kind {
+		case "enum":
+			enumType := "awstypes." + goName(shapeName(target))
+			field.ModelType = "fwtypes.StringEnum[" + enumType + "]"
+			field.SchemaType = "String"
+			field.CustomType = "fwtypes.StringEnumType[" + enumType + "]()"
+		case "timestamp":
+			field.ModelType = "timetypes.RFC3339"
+			field.SchemaType = "String"
+			field.CustomType = "timetypes.RFC3339Type{}"
+		default:
+			field.ModelType = "types." + kind
+			field.SchemaType = kind
+		}
+
+		obj.Fields = append(obj.Fields, field)
+		obj.Attributes = append(obj.Attributes, field)
+
+		return true
+	}
+
+	if shape == nil {
+		return unsupported()
+	}
+
+	switch shape.Type {
+	case "structure":
+		if b.visiting[target] {
+			return unsupported()
+		}
+		nested := b.object(target, computed)
+		field.Object = nested
+		field.ModelType = "fwtypes.ListNestedObjectValueOf[" + nested.Name + "]"
+		field.SchemaType = "List"
+		field.CustomType = "fwtypes.NewListNestedObjectTypeOf[" + nested.Name + "](ctx)"
+		field.MaxOne = true
+
+	case "list", "set":
+		elem := b.model.lookup(shape.Member.Target)
+
+		if elem != nil && elem.Type == "structure" {
+			if b.visiting[shape.Member.Target] {
+				return unsupported()
+			}
+			nested := b.object(shape.Member.Target, computed)
+			field.Object = nested
+			field.ModelType = "fwtypes.ListNestedObjectValueOf[" + nested.Name + "]"
+			field.SchemaType = "List"
+			field.CustomType = "fwtypes.NewListNestedObjectTypeOf[" + nested.Name + "](ctx)"
+			break
+		}
+
+		kind, ok := scalarKind(shape.Member.Target, elem)
+		if !ok || kind == "timestamp" {
+			return unsupported()
+		}
+
+		switch {
+		// Autoflex expands strings to slices of enum values.
+		case (kind == "String" || kind == "enum") && (shape.Type == "set" || member.hasTrait(smithyTraitUniqueItems) || hasShapeTrait(shape, smithyTraitUniqueItems)):
+			field.ModelType = "fwtypes.SetValueOf[types.String]"
+			field.SchemaType = "Set"
+			field.CustomType = "fwtypes.SetOfStringType"
+			field.ElementType = "types.StringType"
+		case kind == "String" || kind == "enum":
+			field.ModelType = "fwtypes.ListValueOf[types.String]"
+			field.SchemaType = "List"
+			field.CustomType = "fwtypes.ListOfStringType"
+			field.ElementType = "types.StringType"
+		default:
+			field.ModelType = "types.List"
+			field.SchemaType = "List"
+			field.ElementType = "types." + kind + "Type"
+		}
+
+		obj.Fields = append(obj.Fields, field)
+		obj.Attributes = append(obj.Attributes, field)
+
+		return true
+
+	case "map":
+		if kind, ok := scalarKind(shape.Value.Target, b.model.lookup(shape.Value.Target)); !ok || kind != "String" {
+			return unsupported()
+		}
+		field.ModelType = "fwtypes.MapValueOf[types.String]"
+		field.SchemaType = "Map"
+		field.CustomType = "fwtypes.MapOfStringType"
+		field.ElementType = "types.StringType"
+
+		obj.Fields = append(obj.Fields, field)
+		obj.Attributes = append(obj.Attributes, field)
+
+		return true
+
+	default:
+		return unsupported()
+	}
+
+	obj.Fields = append(obj.Fields, field)
+	// Computed nested objects can't be blocks.
+	if computed {
+		field.ElementType = "types.ObjectType{AttrTypes: fwtypes.AttributeTypesMust[" + field.Object.Name + "](ctx)}"
+		obj.Attributes = append(obj.Attributes, field)
+	} else {
+		field.Computed = false
+		obj.Blocks = append(obj.Blocks, field)
+	}
+
+	return true
+}
+
+// scalarKind returns the kind of a scalar shape: String, Bool, Int64, Float64, enum or timestamp.
+func scalarKind(target string, shape *smithyShape) (string, bool) {
+	typ := ""
+	if shape != nil {
+		if shape.Type == "string" && hasShapeTrait(shape, smithyTraitEnum) {
+			return "enum", true
+		}
+		typ = shape.Type
+	} else if prelude, ok := strings.CutPrefix(target, "smithy.api#"); ok {
+		typ = strings.ToLower(strings.TrimPrefix(prelude, "Primitive"))
+	}
+
+	switch typ {
+	case "string":
+		return "String", true
+	case "enum":
+		return "enum", true
+	case "boolean":
+		return "Bool", true
+	case "byte", "short", "integer", "long", "intenum":
+		return "Int64", true
+	case "float", "double":
+		return "Float64", true
+	case "timestamp":
+		return "timestamp", true
+	}
+
+	return "", false
+}
+
+func hasShapeTrait(shape *smithyShape, name string) bool {
+	_, ok := shape.Traits[name]
+	return ok
+}
+
+// isTagsMember returns whether the member holds resource tags, which are handled by the provider's tagging support.
+func isTagsMember(m *smithyModel, name string, member *smithyMember) bool {
+	if name != "Tags" && name != "tags" && name != "TagList" {
+		return false
+	}
+
+	shape := m.lookup(member.Target)
+
+	return shape != nil && (shape.Type == "map" || shape.Type == "list")
+}
+
+func sortFields(obj *ObjectData) {
+	cmp := func(a, b *FieldData) int {
+		return strings.Compare(a.TFName, b.TFName)
+	}
+	slices.SortFunc(obj.Fields, cmp)
+	slices.SortFunc(obj.Attributes, cmp)
+	slices.SortFunc(obj.Blocks, cmp)
+}
+
+func sortedMemberNames(shape *smithyShape) []string {
+	if shape == nil {
+		return nil
+	}
+
+	return sortedKeys(shape.Members)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// goName returns the AWS SDK for Go v2 name of a shape or member.
+func goName(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// enumConstantName returns the suffix of the AWS SDK for Go v2 constant for an enum value (e.g. "InProgress" for "IN_PROGRESS").
+func enumConstantName(name string) string {
+	var sb strings.Builder
+
+	for _, word := range regexache.MustCompile(`[^A-Za-z0-9]+`).Split(name, -1) {
+		if word == "" {
+			continue
+		}
+		if word == strings.ToUpper(word) {
+			word = strings.ToLower(word)
+		}
+		sb.WriteString(goName(word))
+	}
+
+	return sb.String()
+}
+
+// imports returns the standard library and other imports required by the generated resource.
+func imports(data *ModelData, servicePackage string, tags bool) ([]string, []string) {
+	std := []string{`"context"`}
+
+	imports := []string{
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		fmt.Sprintf(`"github.com/aws/aws-sdk-go-v2/service/%s"`, servicePackage),
+		fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, servicePackage),
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/create"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+		`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+		`"github.com/hashicorp/terraform-provider-aws/names"`,
+	}
+	if data.ClientToken != "" {
+		imports = append(imports, `sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"`)
+	}
+	if data.Status != nil {
+		std = append(std, `"time"`)
+		imports = append(imports,
+			`"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+			`"github.com/hashicorp/terraform-provider-aws/internal/enum"`,
+		)
+	}
+	if tags {
+		imports = append(imports, `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`)
+	}
+
+	objects := append([]*ObjectData{data.Root}, data.Objects...)
+	uses := func(f func(*FieldData) bool) bool {
+		for _, obj := range objects {
+			if slices.ContainsFunc(obj.Fields, f) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if uses(func(f *FieldData) bool { return f.Object != nil || strings.Contains(f.ModelType, "fwtypes.") }) {
+		imports = append(imports, `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+	}
+	if uses(func(f *FieldData) bool { return f.Object != nil && !f.Computed && (f.Required || f.MaxOne) }) {
+		imports = append(imports,
+			`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+			`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+		)
+	}
+	if uses(func(f *FieldData) bool { return f.ModelType == "timetypes.RFC3339" }) {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`)
+	}
+	if uses(func(f *FieldData) bool { return f.RequiresReplace || f.Computed }) {
+		imports = append(imports, `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`)
+	}
+	for _, schemaType := range []string{"Bool", "Float64", "Int64", "List", "Map", "Set", "String"} {
+		if uses(func(f *FieldData) bool {
+			return f.SchemaType == schemaType && (f.RequiresReplace || f.Computed)
+		}) {
+			imports = append(imports, fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%splanmodifier"`, strings.ToLower(schemaType)))
+		}
+	}
+
+	sortImports := func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	}
+	slices.SortFunc(std, sortImports)
+	slices.SortFunc(imports, sortImports)
+
+	return std, imports
+}
+
+func importPath(spec string) string {
+	if _, path, ok := strings.Cut(spec, " "); ok {
+		return path
+	}
+
+	return spec
+}
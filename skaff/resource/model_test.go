@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDefaultOperations(t *testing.T) {
+	m, err := loadModel("testdata/docdbelastic.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		TestName string
+		Name     string
+		Input    Operations
+		Expected Operations
+	}{
+		{
+			TestName: "defaults",
+			Name:     "Cluster",
+			Expected: Operations{
+				Create: "CreateCluster",
+				Read:   "GetCluster",
+				Update: "UpdateCluster",
+				Delete: "DeleteCluster",
+				List:   "ListClusters",
+			},
+		},
+		{
+			TestName: "no update or list",
+			Name:     "Widget",
+			Expected: Operations{
+				Create: "CreateWidget",
+				Read:   "GetWidget",
+				Delete: "DeleteWidget",
+			},
+		},
+		{
+			TestName: "explicit",
+			Name:     "ElasticCluster",
+			Input: Operations{
+				Create: "CreateCluster",
+				Read:   "GetCluster",
+			},
+			Expected: Operations{
+				Create: "CreateCluster",
+				Read:   "GetCluster",
+				Delete: "DeleteElasticCluster",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := m.defaultOperations(testCase.Name, testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestNewModelData(t *testing.T) {
+	m, err := loadModel("testdata/docdbelastic.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := newModelData(m, "Cluster", "docdbelastic", Operations{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := data.IDMember, "ClusterArn"; got != expected {
+		t.Errorf("IDMember: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.IDFromOutput, "Cluster.ClusterArn"; got != expected {
+		t.Errorf("IDFromOutput: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.ClientToken, "ClientToken"; got != expected {
+		t.Errorf("ClientToken: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.FindMember, "Cluster"; got != expected {
+		t.Errorf("FindMember: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.FindType, "*awstypes.Cluster"; got != expected {
+		t.Errorf("FindType: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.NotFoundException, "ResourceNotFoundException"; got != expected {
+		t.Errorf("NotFoundException: got %s, expected %s", got, expected)
+	}
+	if got, expected := data.TagsIdentifier, "cluster_arn"; got != expected {
+		t.Errorf("TagsIdentifier: got %s, expected %s", got, expected)
+	}
+
+	if data.Status == nil {
+		t.Fatal("expected status")
+	}
+	if got, expected := data.Status.CreateTarget, []string{"awstypes.StatusActive"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Status.CreateTarget: got %v, expected %v", got, expected)
+	}
+	if got, expected := data.Status.DeletePending, []string{"awstypes.StatusDeleting"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Status.DeletePending: got %v, expected %v", got, expected)
+	}
+
+	expectedList := &ListData{
+		Operation: "ListClusters",
+		Paginated: true,
+		Items:     "Clusters",
+		IDMember:  "ClusterArn",
+	}
+	if !reflect.DeepEqual(data.List, expectedList) {
+		t.Errorf("List: got %+v, expected %+v", data.List, expectedList)
+	}
+
+	fields := make(map[string]*FieldData)
+	for _, v := range data.Root.Fields {
+		fields[v.TFName] = v
+	}
+
+	testCases := []struct {
+		TFName          string
+		Required        bool
+		Optional        bool
+		Computed        bool
+		RequiresReplace bool
+		Sensitive       bool
+	}{
+		{TFName: "admin_user_name", Required: true, RequiresReplace: true},
+		{TFName: "admin_user_password", Required: true, Sensitive: true},
+		{TFName: "cluster_arn", Computed: true},
+		{TFName: "cluster_endpoint", Computed: true},
+		{TFName: "cluster_name", Required: true, RequiresReplace: true},
+		{TFName: "shard_count", Required: true},
+		{TFName: "subnet_ids", Optional: true, Computed: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TFName, func(t *testing.T) {
+			f, ok := fields[testCase.TFName]
+			if !ok {
+				t.Fatalf("field %s not found", testCase.TFName)
+			}
+
+			if f.Required != testCase.Required || f.Optional != testCase.Optional || f.Computed != testCase.Computed || f.RequiresReplace != testCase.RequiresReplace || f.Sensitive != testCase.Sensitive {
+				t.Errorf("got %+v, expected %+v", *f, testCase)
+			}
+		})
+	}
+}
@@ -8,9 +8,14 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -30,6 +35,18 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourcemodel.tmpl
+var resourceModelTmpl string
+
+//go:embed resourcemodeltest.tmpl
+var resourceModelTestTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+//go:embed exports.tmpl
+var exportsTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,6 +62,7 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	Model                *ModelData
 }
 
 func ToSnakeCase(upper string, snakeName string) string {
@@ -72,43 +90,117 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, v2, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err = writeWebsiteDoc(force, templateData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateFromModel generates a Plugin Framework resource using AWS SDK for Go v2 from an AWS API model.
+// The resource's CRUD handlers, finder, status and wait functions, sweeper and acceptance tests are generated
+// from the shapes of the specified operations.
+func CreateFromModel(resName, snakeName, modelFile string, ops Operations, force, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, false, true, true, tags)
+	if err != nil {
+		return err
+	}
+
+	m, err := loadModel(modelFile)
+	if err != nil {
+		return err
+	}
+
+	if templateData.Model, err = newModelData(m, resName, templateData.ServicePackage, ops, tags); err != nil {
+		return fmt.Errorf("reading AWS API model (%s): %w", modelFile, err)
+	}
+
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceModelTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err = addToGoFile("exportsfile", "exports_test.go", exportsTmpl, templateData, addExports); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	if templateData.Model.List != nil {
+		if err = addToGoFile("sweepfile", "sweep.go", sweepTmpl, templateData, addSweeper); err != nil {
+			return fmt.Errorf("writing sweeper: %w", err)
+		}
+	} else {
+		fmt.Printf("No list operation found for %s, skipping sweeper\n", resName)
+	}
+
+	if err = writeWebsiteDoc(force, templateData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, v2, pluginFramework, tags bool) (TemplateData, error) {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
-		return fmt.Errorf("error reading working directory: %s", err)
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
 	}
 
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -123,25 +215,13 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    HumanResName(resName),
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
-	}
-
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
+	}, nil
+}
 
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+func writeWebsiteDoc(force bool, td TemplateData) error {
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, td.ResourceSnake)
 	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
@@ -186,3 +266,181 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 
 	return nil
 }
+
+// writeGoTemplate is like writeTemplate, but the output is formatted Go source.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	b, err := executeTemplate(templateName, tmpl, templateName, td)
+	if err != nil {
+		return err
+	}
+
+	return writeGoSource(filename, b)
+}
+
+// addToGoFile renders the named template into a new file or, if the file already exists,
+// uses add to merge the template's definitions into the existing source.
+func addToGoFile(templateName, filename, tmpl string, td TemplateData, add func(src []byte, tmpl string, td TemplateData) ([]byte, error)) error {
+	src, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		b, err := executeTemplate(templateName, tmpl, templateName, td)
+		if err != nil {
+			return err
+		}
+
+		return writeGoSource(filename, b)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	b, err := add(src, tmpl, td)
+	if err != nil {
+		return fmt.Errorf("adding to file (%s): %w", filename, err)
+	}
+
+	return writeGoSource(filename, b)
+}
+
+// addExports adds the resource's test exports to an existing exports_test.go.
+func addExports(src []byte, tmpl string, td TemplateData) ([]byte, error) {
+	exports, err := executeTemplate("exportsfile", tmpl, "exports", td)
+	if err != nil {
+		return nil, err
+	}
+
+	s := string(src)
+	if i := strings.Index(s, "var (\n"); i >= 0 {
+		if j := strings.Index(s[i:], "\n)\n"); j >= 0 {
+			j += i
+			return []byte(s[:j] + "\n" + string(exports) + s[j:]), nil
+		}
+	}
+
+	return []byte(s + "\nvar (" + string(exports) + "\n)\n"), nil
+}
+
+// addSweeper registers the resource's sweeper in an existing sweep.go and appends the sweeper function.
+func addSweeper(src []byte, tmpl string, td TemplateData) ([]byte, error) {
+	register, err := executeTemplate("sweepfile", tmpl, "register", td)
+	if err != nil {
+		return nil, err
+	}
+	sweeper, err := executeTemplate("sweepfile", tmpl, "sweeper", td)
+	if err != nil {
+		return nil, err
+	}
+
+	s := string(src)
+
+	const registerSweepers = "func RegisterSweepers() {"
+	i := strings.Index(s, registerSweepers)
+	if i < 0 {
+		return nil, fmt.Errorf("%s not found", registerSweepers)
+	}
+	j := strings.Index(s[i:], "\n}\n")
+	if j < 0 {
+		return nil, fmt.Errorf("end of %s not found", registerSweepers)
+	}
+	i += j
+	s = s[:i] + string(register) + s[i:] + string(sweeper) + "\n"
+
+	return addImports([]byte(s), []string{
+		"context",
+		"github.com/aws/aws-sdk-go-v2/aws",
+		"github.com/aws/aws-sdk-go-v2/service/" + td.ServicePackage,
+		"github.com/hashicorp/terraform-provider-aws/internal/conns",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework",
+	})
+}
+
+// addImports adds any missing imports to Go source.
+// It is an error if the source already imports a different package with the same name.
+func addImports(src []byte, paths []string) ([]byte, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make(map[string]string) // name -> path.
+	for _, v := range f.Imports {
+		p, err := strconv.Unquote(v.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		name := path.Base(p)
+		if v.Name != nil {
+			name = v.Name.Name
+		}
+		imported[name] = p
+	}
+
+	var std, other []string
+	for _, p := range paths {
+		if v, ok := imported[path.Base(p)]; ok {
+			if v != p {
+				return nil, fmt.Errorf("package %s is imported from %s, not %s", path.Base(p), v, p)
+			}
+			continue
+		}
+		// Standard library imports go in the first group, all others in the last.
+		if strings.Contains(p, ".") {
+			other = append(other, "\t"+strconv.Quote(p)+"\n")
+		} else {
+			std = append(std, "\t"+strconv.Quote(p)+"\n")
+		}
+	}
+
+	if len(std) == 0 && len(other) == 0 {
+		return src, nil
+	}
+
+	s := string(src)
+	i := strings.Index(s, "import (\n")
+	if i < 0 {
+		return nil, fmt.Errorf("import declaration not found")
+	}
+	i += len("import (\n")
+	j := strings.Index(s[i:], "\n)\n")
+	if j < 0 {
+		return nil, fmt.Errorf("end of import declaration not found")
+	}
+	j += i + 1
+
+	return []byte(s[:i] + strings.Join(std, "") + s[i:j] + strings.Join(other, "") + s[j:]), nil
+}
+
+func executeTemplate(templateName, tmpl, name string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.ExecuteTemplate(&buffer, name, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeGoSource(filename string, src []byte) error {
+	contents, err := format.Source(src)
+	if err != nil {
+		// Write the unformatted source to help diagnose the problem.
+		contents = src
+		err = fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	if werr := os.WriteFile(filename, contents, 0644); werr != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, werr)
+	}
+
+	return err
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type tagFlags map[string]string
+
+func (f tagFlags) String() string {
+	var s []string
+	for k, v := range f {
+		s = append(s, k+"="+v)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f tagFlags) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("tag must be of the form key=value: %q", s)
+	}
+	f[k] = v
+	return nil
+}
+
+var (
+	list       = flag.Bool("list", false, "List the resource types that can be exported and exit")
+	namePrefix = flag.String("name-prefix", "", "Only export resources whose name, or import ID if they have no name, starts with this prefix")
+	out        = flag.String("out", "", "Path of the file to write import blocks to (default stdout)")
+	regions    = flag.String("regions", "", "Comma-separated list of AWS Regions to export resources from (required)")
+	services   = flag.String("services", "", "Comma-separated list of service packages, as used in the provider's endpoints block, to export resources from (default all)")
+	tags       = tagFlags{}
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\ttfexport -regions <regions> [-services <services>] [-tag <key>=<value>]... [-name-prefix <prefix>] [-out <file>]\n")
+	fmt.Fprintf(os.Stderr, "\ttfexport -list [-services <services>]\n\n")
+	fmt.Fprintf(os.Stderr, "Only resource types whose sweepers are registered via sweep.Register can be exported.\n")
+	fmt.Fprintf(os.Stderr, "Other resource types are skipped and listed on stderr.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Var(tags, "tag", "Only export resources with this tag, key=value (can be repeated)")
+	flag.Usage = usage
+	flag.Parse()
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+	registerSweepers()
+
+	var servicePackageNames []string
+	for _, v := range splitList(*services) {
+		pkg, err := names.ProviderPackageForAlias(v)
+		if err != nil {
+			log.Fatalf("[ERROR] %s", err)
+		}
+		servicePackageNames = append(servicePackageNames, pkg)
+	}
+
+	resourceTypes, skipped := sweep.ListableSweepers(ctx, servicePackageNames...)
+	typeNames := make([]string, 0, len(resourceTypes))
+	for k := range resourceTypes {
+		typeNames = append(typeNames, k)
+	}
+	slices.Sort(typeNames)
+
+	if *list {
+		for _, v := range typeNames {
+			fmt.Println(v)
+		}
+		logSkipped(skipped)
+		return
+	}
+
+	if *regions == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	logSkipped(skipped)
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("[ERROR] creating %s: %s", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	e := newExporter(tags, *namePrefix)
+	importRegions := sweep.ImportRegionResourceTypes(ctx)
+	imports := hclwrite.NewEmptyFile()
+	// providerAliases contains the Regions whose resources are imported using an aliased provider configuration.
+	providerAliases := make(map[string]bool)
+
+	for _, typeName := range typeNames {
+		global := names.IsGlobalService(resourceTypes[typeName])
+
+		for i, region := range splitList(*regions) {
+			// Resources of global services are the same in all Regions.
+			if global && i > 0 {
+				break
+			}
+
+			resources, err := e.export(ctx, typeName, region)
+			if err != nil {
+				log.Printf("[WARN] Exporting %s (%s): %s", typeName, region, err)
+				continue
+			}
+
+			for _, v := range resources {
+				block := imports.Body().AppendNewBlock("import", nil).Body()
+				block.SetAttributeTraversal("to", hcl.Traversal{
+					hcl.TraverseRoot{Name: typeName},
+					hcl.TraverseAttr{Name: v.label},
+				})
+
+				importID := v.importID
+				switch {
+				case global:
+				case importRegions[typeName]:
+					// The import ID's `@region` suffix sets the resource's `region` argument.
+					importID += "@" + region
+				default:
+					// Other Regional resources are imported using a provider configured for their Region.
+					providerAliases[region] = true
+					block.SetAttributeTraversal("provider", hcl.Traversal{
+						hcl.TraverseRoot{Name: "aws"},
+						hcl.TraverseAttr{Name: providerAlias(region)},
+					})
+				}
+				block.SetAttributeValue(names.AttrID, cty.StringVal(importID))
+				imports.Body().AppendNewline()
+			}
+		}
+	}
+
+	providers := hclwrite.NewEmptyFile()
+	for _, region := range splitList(*regions) {
+		if !providerAliases[region] {
+			continue
+		}
+
+		block := providers.Body().AppendNewBlock("provider", []string{"aws"}).Body()
+		block.SetAttributeValue("alias", cty.StringVal(providerAlias(region)))
+		block.SetAttributeValue(names.AttrRegion, cty.StringVal(region))
+		providers.Body().AppendNewline()
+	}
+
+	for _, file := range []*hclwrite.File{providers, imports} {
+		if _, err := file.WriteTo(w); err != nil {
+			log.Fatalf("[ERROR] writing import blocks: %s", err)
+		}
+	}
+}
+
+// providerAlias returns the alias of the provider configuration for the specified Region.
+func providerAlias(region string) string {
+	return strings.ReplaceAll(region, "-", "_")
+}
+
+// logSkipped writes the resource types that can't be exported to stderr.
+func logSkipped(skipped []string) {
+	if len(skipped) == 0 {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Skipping %d resource types whose sweepers are not registered via sweep.Register:\n", len(skipped))
+	for _, v := range skipped {
+		fmt.Fprintf(os.Stderr, "\t- %s\n", v)
+	}
+}
+
+type exportedResource struct {
+	importID string
+	label    string
+}
+
+// exporter lists resources via the sweepers registered with sweep.Register, without deleting them.
+type exporter struct {
+	labels     map[string]int
+	namePrefix string
+	tags       map[string]string
+	// taggedARNs contains, for each Region, the ARNs of resources with all of the tags.
+	taggedARNs map[string]map[string]bool
+}
+
+func newExporter(tags map[string]string, namePrefix string) *exporter {
+	return &exporter{
+		labels:     make(map[string]int),
+		namePrefix: namePrefix,
+		tags:       tags,
+		taggedARNs: make(map[string]map[string]bool),
+	}
+}
+
+func (e *exporter) export(ctx context.Context, typeName, region string) ([]exportedResource, error) {
+	ctx = sweep.ContextWithRegion(ctx, region)
+
+	sweepables, err := sweep.List(ctx, region, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var taggedARNs map[string]bool
+	if len(e.tags) > 0 {
+		taggedARNs, err = e.findTaggedARNs(ctx, region)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resources []exportedResource
+	for _, sweepable := range sweepables {
+		v, ok := sweepable.(sweep.Exportable)
+		if !ok {
+			return nil, fmt.Errorf("export not supported: %T", sweepable)
+		}
+
+		importID := v.ImportID()
+		if importID == "" {
+			return nil, errors.New("export not supported: sweeper doesn't set import IDs")
+		}
+		name := importID
+
+		if e.namePrefix != "" || taggedARNs != nil {
+			attributes, found, err := v.ReadAttributes(ctx, names.AttrARN, names.AttrName)
+			if err != nil {
+				log.Printf("[WARN] Reading %s (%s): %s", typeName, importID, err)
+				continue
+			}
+			if !found {
+				continue
+			}
+
+			if v := attributes[names.AttrName]; v != "" {
+				name = v
+			}
+
+			if taggedARNs != nil && !taggedARNs[attributes[names.AttrARN]] {
+				continue
+			}
+		}
+
+		if !strings.HasPrefix(name, e.namePrefix) {
+			continue
+		}
+
+		resources = append(resources, exportedResource{
+			importID: importID,
+			label:    e.label(typeName, name),
+		})
+	}
+
+	return resources, nil
+}
+
+// findTaggedARNs returns the ARNs of the resources in the specified Region that have all of the exporter's tags.
+func (e *exporter) findTaggedARNs(ctx context.Context, region string) (map[string]bool, error) {
+	if v, ok := e.taggedARNs[region]; ok {
+		return v, nil
+	}
+
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+	for k, v := range e.tags {
+		input.TagFilters = append(input.TagFilters, awstypes.TagFilter{
+			Key:    aws.String(k),
+			Values: []string{v},
+		})
+	}
+
+	arns, err := findResourceARNs(ctx, client, input)
+	if err != nil {
+		return nil, fmt.Errorf("listing tagged resources: %w", err)
+	}
+
+	e.taggedARNs[region] = arns
+
+	return arns, nil
+}
+
+func findResourceARNs(ctx context.Context, client *conns.AWSClient, input *resourcegroupstaggingapi.GetResourcesInput) (map[string]bool, error) {
+	arns := make(map[string]bool)
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(client.ResourceGroupsTaggingAPIClient(ctx), input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			arns[aws.ToString(v.ResourceARN)] = true
+		}
+	}
+
+	return arns, nil
+}
+
+// label returns a unique Terraform resource name for a resource of the specified type with the specified name.
+func (e *exporter) label(typeName, name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	label := strings.Trim(sb.String(), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "r_" + label
+	}
+
+	key := typeName + "." + label
+	e.labels[key]++
+	if n := e.labels[key]; n > 1 {
+		label = fmt.Sprintf("%s_%d", label, n)
+	}
+
+	return label
+}
+
+func splitList(s string) []string {
+	var l []string
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+	return l
+}
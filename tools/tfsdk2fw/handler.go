@@ -0,0 +1,1443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+const (
+	importPathFmt       = "fmt"
+	importPathFWDiag    = "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	importPathFWFlex    = "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	importPathFWTypes   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	importPathTFTypes   = "github.com/hashicorp/terraform-plugin-framework/types"
+	importPathTimeouts  = "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	importPathSDKSchema = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	// Plugin SDK packages whose use cannot be migrated as-is.
+	sdkImportPaths = []string{
+		"github.com/hashicorp/terraform-plugin-sdk/v2/diag",
+		importPathSDKSchema,
+		"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag",
+	}
+
+	closureNameRegexp = regexp.MustCompile(`\.func\d+$`)
+)
+
+// sourceFile is a parsed Go source file.
+type sourceFile struct {
+	fset    *token.FileSet
+	file    *ast.File
+	src     []byte
+	imports map[string]string // Package name to import path.
+}
+
+func parseSourceFile(filename string) (*sourceFile, error) {
+	src, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string)
+	for _, v := range file.Imports {
+		importPath, err := strconv.Unquote(v.Path.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(importPath)
+		if v.Name != nil {
+			name = v.Name.Name
+		}
+		imports[name] = importPath
+	}
+
+	return &sourceFile{
+		fset:    fset,
+		file:    file,
+		src:     src,
+		imports: imports,
+	}, nil
+}
+
+// funcDecl returns the named top-level function declaration.
+func (f *sourceFile) funcDecl(name string) *ast.FuncDecl {
+	for _, v := range f.file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Recv == nil && v.Name.Name == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// methodDecl returns the declaration of the named method, of any receiver type, in the file.
+func (f *sourceFile) methodDecl(name string) *ast.FuncDecl {
+	for _, v := range f.file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Recv != nil && v.Name.Name == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// source returns the source code of the specified node.
+func (f *sourceFile) source(n ast.Node) string {
+	return string(f.src[f.fset.Position(n.Pos()).Offset:f.fset.Position(n.End()).Offset])
+}
+
+// keyValues returns the values of any key-value expressions in the specified node, keyed by key.
+func keyValues(n ast.Node) map[string]ast.Expr {
+	kvs := make(map[string]ast.Expr)
+
+	ast.Inspect(n, func(n ast.Node) bool {
+		if v, ok := n.(*ast.KeyValueExpr); ok {
+			if k, ok := v.Key.(*ast.Ident); ok {
+				kvs[k.Name] = v.Value
+			}
+		}
+		return true
+	})
+
+	return kvs
+}
+
+// funcName returns the name of a function.
+// The names of anonymous functions and methods are not returned.
+func funcName(fn any) string {
+	if v := reflect.ValueOf(fn); v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+
+	if f == nil {
+		return ""
+	}
+
+	name := f.Name()
+
+	if closureNameRegexp.MatchString(name) || strings.HasSuffix(name, "-fm") {
+		return ""
+	}
+
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// sourceResource is the source code of a Plugin SDK resource or data source.
+type sourceResource struct {
+	factory  string // Name of the function returning the *schema.Resource.
+	files    []*sourceFile
+	handlers map[string]string // Operation to handler function name.
+}
+
+// newSourceResource locates and parses the source code for the specified Plugin SDK resource or data source
+// in the specified service package directory.
+// The resource's factory function is found from the service package's registrations
+// and the CRUD handler functions from the factory function.
+func newSourceResource(dirname, tfTypeName string, isDataSource bool) (*sourceResource, error) {
+	s := &sourceResource{
+		handlers: make(map[string]string),
+	}
+
+	entries, err := os.ReadDir(dirname)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range entries {
+		if v.IsDir() || !strings.HasSuffix(v.Name(), ".go") || strings.HasSuffix(v.Name(), "_test.go") {
+			continue
+		}
+
+		filename := path.Join(dirname, v.Name())
+		f, err := parseSourceFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		s.files = append(s.files, f)
+	}
+
+	// Data sources and resources may share a type name, so only look in the matching registrations.
+	registrations := "SDKResources"
+	if isDataSource {
+		registrations = "SDKDataSources"
+	}
+
+	for _, f := range s.files {
+		decl := f.methodDecl(registrations)
+
+		if decl == nil || decl.Body == nil {
+			continue
+		}
+
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok && s.factory == "" {
+				kvs := keyValues(lit)
+
+				if v, ok := kvs["TypeName"].(*ast.BasicLit); ok && v.Value == strconv.Quote(tfTypeName) {
+					if v, ok := kvs["Factory"].(*ast.Ident); ok {
+						s.factory = v.Name
+					}
+				}
+			}
+			return s.factory == ""
+		})
+	}
+
+	if s.factory == "" {
+		return nil, fmt.Errorf("factory function for %s not found in %s", tfTypeName, dirname)
+	}
+
+	decl, _ := s.funcDecl(s.factory)
+
+	if decl == nil || decl.Body == nil {
+		return nil, fmt.Errorf("factory function %s not found", s.factory)
+	}
+
+	handlers := map[string][]string{
+		"Read": {"ReadWithoutTimeout", "ReadContext"},
+	}
+	if !isDataSource {
+		handlers["Create"] = []string{"CreateWithoutTimeout", "CreateContext"}
+		handlers["Update"] = []string{"UpdateWithoutTimeout", "UpdateContext"}
+		handlers["Delete"] = []string{"DeleteWithoutTimeout", "DeleteContext"}
+	}
+
+	kvs := keyValues(decl.Body)
+
+	for op, keys := range handlers {
+		for _, key := range keys {
+			if v, ok := kvs[key].(*ast.Ident); ok {
+				s.handlers[op] = v.Name
+				break
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// funcDecl returns the named function declaration and its source file.
+func (s *sourceResource) funcDecl(name string) (*ast.FuncDecl, *sourceFile) {
+	for _, f := range s.files {
+		if decl := f.funcDecl(name); decl != nil {
+			return decl, f
+		}
+	}
+
+	return nil, nil
+}
+
+// handler returns the declaration and source file of the specified operation's handler function.
+func (s *sourceResource) handler(op string) (*ast.FuncDecl, *sourceFile) {
+	name, ok := s.handlers[op]
+
+	if !ok {
+		return nil, nil
+	}
+
+	return s.funcDecl(name)
+}
+
+// translator translates the body of a Plugin SDK CRUD handler function into Plugin Framework code.
+// Statements that cannot be translated are emitted as TODO comments.
+type translator struct {
+	*sourceFile
+	attributes  map[string]*schema.Schema // Top-level attributes.
+	fields      map[string]modelField     // Top-level model struct fields.
+	generated   map[*ast.Ident]bool       // Identifiers introduced by translation.
+	hasTimeouts bool
+	imports     map[string]string // Import path to name, for imports required by the translated code.
+	readFunc    string            // Name of the Plugin SDK Read handler function.
+	receiver    string            // Name of the Plugin Framework method receiver.
+	tfTypeName  string
+
+	// Per-handler state.
+	op            string
+	dataVar       string // Name of the model variable.
+	d             string // Name of the *schema.ResourceData parameter.
+	meta          string // Name of the meta parameter.
+	diags         string // Name of the diag.Diagnostics variable.
+	flattenSource string // Expression that model values are flattened from.
+	flattened     bool
+	depth         int // Block nesting depth, used to indent comments.
+	skip          map[ast.Stmt]bool
+	w             strings.Builder
+}
+
+func newTranslator(f *sourceFile, attributes map[string]*schema.Schema, fields []modelField, hasTimeouts bool, receiver, readFunc, tfTypeName string, imports map[string]string) *translator {
+	t := &translator{
+		sourceFile:  f,
+		attributes:  attributes,
+		fields:      make(map[string]modelField),
+		generated:   make(map[*ast.Ident]bool),
+		hasTimeouts: hasTimeouts,
+		imports:     imports,
+		readFunc:    readFunc,
+		receiver:    receiver,
+		tfTypeName:  tfTypeName,
+	}
+
+	for _, v := range fields {
+		t.fields[v.Name] = v
+	}
+
+	return t
+}
+
+// translate returns the Plugin Framework code for the body of the specified Plugin SDK handler function.
+func (t *translator) translate(op string, decl *ast.FuncDecl) string {
+	t.op = op
+	t.dataVar = "data"
+	if op == "Update" {
+		t.dataVar = "new"
+	}
+	t.d, t.meta, t.diags = "", "", ""
+	t.flattenSource, t.flattened = "", false
+	t.depth = 1
+	t.skip = make(map[ast.Stmt]bool)
+	t.w.Reset()
+
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			switch typ := t.source(field.Type); typ {
+			case "*schema.ResourceData":
+				t.d = name.Name
+			case "interface{}", "any":
+				t.meta = name.Name
+			}
+		}
+	}
+
+	t.flattenSource = t.findFlattenSource(decl.Body)
+
+	var prevEnd int
+	stmts := decl.Body.List
+	for i, stmt := range stmts {
+		if i == len(stmts)-1 {
+			if stmt, ok := stmt.(*ast.ReturnStmt); ok && t.isFinalReturn(stmt) {
+				break
+			}
+		}
+
+		prevEnd = t.blankLine(prevEnd, stmt)
+		t.stmt(stmt, stmts[i+1:])
+	}
+
+	if op != "Delete" {
+		if op == "Create" {
+			t.printf("\n")
+			t.commentf("TODO Set values for any unknown attributes.")
+		}
+		t.printf("\nresponse.Diagnostics.Append(response.State.Set(ctx, &%s)...)\n", t.dataVar)
+	}
+
+	return strings.TrimSpace(t.w.String())
+}
+
+func (t *translator) printf(format string, a ...any) {
+	fmt.Fprintf(&t.w, format, a...)
+}
+
+// stmts emits the Plugin Framework code for a list of statements.
+func (t *translator) stmts(stmts []ast.Stmt) {
+	t.depth++
+	defer func() { t.depth-- }()
+
+	var prevEnd int
+	for i, stmt := range stmts {
+		prevEnd = t.blankLine(prevEnd, stmt)
+		t.stmt(stmt, stmts[i+1:])
+	}
+}
+
+// blankLine emits a blank line if there is one between the previously translated statement and the specified statement in the source.
+// Returns the source end line of the previously translated statement.
+// Must be called before the specified statement is translated.
+func (t *translator) blankLine(prevEnd int, stmt ast.Stmt) int {
+	if t.skip[stmt] {
+		return prevEnd
+	}
+
+	if prevEnd > 0 && t.fset.Position(stmt.Pos()).Line > prevEnd+1 {
+		t.printf("\n")
+	}
+
+	return t.fset.Position(stmt.End()).Line
+}
+
+// stmt emits the Plugin Framework code for a statement.
+// rest contains any following statements in the same block.
+func (t *translator) stmt(stmt ast.Stmt, rest []ast.Stmt) {
+	if t.skip[stmt] {
+		return
+	}
+
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		// var diags diag.Diagnostics
+		if decl, ok := stmt.Decl.(*ast.GenDecl); ok && decl.Tok == token.VAR && len(decl.Specs) == 1 {
+			if spec, ok := decl.Specs[0].(*ast.ValueSpec); ok && spec.Type != nil && t.source(spec.Type) == "diag.Diagnostics" && len(spec.Names) == 1 {
+				t.diags = spec.Names[0].Name
+				return
+			}
+		}
+
+	case *ast.ExprStmt:
+		if call, ok := stmt.X.(*ast.CallExpr); ok {
+			switch {
+			case t.isDCall(call, "SetId") && len(call.Args) == 1:
+				t.setID(stmt, call.Args[0])
+				return
+
+			case t.isDCall(call, "Set") && len(call.Args) == 2:
+				t.set(stmt, call.Args[0], call.Args[1])
+				return
+			}
+		}
+
+	case *ast.AssignStmt:
+		if t.input(stmt, rest) {
+			return
+		}
+
+	case *ast.BlockStmt:
+		t.printf("{\n")
+		t.stmts(stmt.List)
+		t.printf("}\n")
+		return
+
+	case *ast.IfStmt:
+		t.ifStmt(stmt, rest)
+		return
+
+	case *ast.RangeStmt:
+		body := stmt.Body
+		stmt.Body = &ast.BlockStmt{}
+		t.rewrite(stmt)
+		if t.hasSDKReferences(stmt) {
+			stmt.Body = body
+			t.comment(stmt)
+			return
+		}
+		header := t.print(stmt)
+		t.printf("%s\n", strings.TrimSuffix(strings.TrimSpace(header), "}"))
+		t.stmts(body.List)
+		t.printf("}\n")
+		return
+
+	case *ast.ReturnStmt:
+		t.returnStmt(stmt)
+		return
+	}
+
+	t.generic(stmt)
+}
+
+// generic emits a statement with any Plugin SDK expressions rewritten.
+func (t *translator) generic(stmt ast.Stmt) {
+	t.rewrite(stmt)
+
+	if t.hasSDKReferences(stmt) {
+		t.comment(stmt)
+		return
+	}
+
+	t.printf("%s\n", t.print(stmt))
+}
+
+// comment emits a statement that could not be translated as a TODO comment.
+func (t *translator) comment(n ast.Node) {
+	indent := t.fset.Position(n.Pos()).Column - 1
+	for i, line := range strings.Split(t.source(n), "\n") {
+		if i == 0 {
+			t.commentf("TODO %s", line)
+			continue
+		}
+		for j := 0; j < indent && strings.HasPrefix(line, "\t"); j++ {
+			line = line[1:]
+		}
+		t.commentf("%s", line)
+	}
+}
+
+// commentf emits a formatted comment line, indented to the current block nesting depth.
+// Comments are not re-indented when the translated code is formatted.
+func (t *translator) commentf(format string, a ...any) {
+	t.printf("%s// %s\n", strings.Repeat("\t", t.depth), fmt.Sprintf(format, a...))
+}
+
+// ifStmt emits the Plugin Framework code for an if statement.
+func (t *translator) ifStmt(stmt *ast.IfStmt, rest []ast.Stmt) {
+	// if err := d.Set("name", v); err != nil { ... }
+	if init, ok := stmt.Init.(*ast.AssignStmt); ok && len(init.Rhs) == 1 {
+		if call, ok := init.Rhs[0].(*ast.CallExpr); ok && t.isDCall(call, "Set") && len(call.Args) == 2 {
+			t.set(stmt, call.Args[0], call.Args[1])
+			return
+		}
+	}
+
+	// if !d.IsNewResource() && tfresource.NotFound(err) { ... d.SetId("") ... }
+	if t.op == "Read" && t.setsEmptyID(stmt.Body) {
+		// The not found error is the first error variable in the condition.
+		var err ast.Expr
+		ast.Inspect(stmt.Cond, func(n ast.Node) bool {
+			if v, ok := n.(*ast.Ident); ok && v.Name == "err" {
+				err = v
+			}
+			return err == nil
+		})
+
+		if err != nil && stmt.Init == nil {
+			stmt.Cond = t.expr(stmt.Cond)
+
+			if !t.hasSDKReferences(stmt.Cond) {
+				t.useImport(importPathFWDiag, "")
+				t.printf("if %s {\n", t.print(stmt.Cond))
+				t.printf("response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(%s))\n", t.print(err))
+				t.printf("response.State.RemoveResource(ctx)\n\nreturn\n}\n")
+				return
+			}
+		}
+
+		t.comment(stmt)
+		return
+	}
+
+	if stmt.Init != nil {
+		t.rewrite(stmt.Init)
+	}
+	stmt.Cond = t.expr(stmt.Cond)
+
+	if (stmt.Init != nil && t.hasSDKReferences(stmt.Init)) || t.hasSDKReferences(stmt.Cond) {
+		t.comment(stmt)
+		return
+	}
+
+	if stmt.Init != nil {
+		t.printf("if %s; %s {\n", t.print(stmt.Init), t.print(stmt.Cond))
+	} else {
+		t.printf("if %s {\n", t.print(stmt.Cond))
+	}
+	t.stmts(stmt.Body.List)
+
+	switch els := stmt.Else.(type) {
+	case nil:
+		t.printf("}\n")
+	case *ast.IfStmt:
+		t.printf("} else ")
+		t.ifStmt(els, rest)
+	case *ast.BlockStmt:
+		t.printf("} else {\n")
+		t.stmts(els.List)
+		t.printf("}\n")
+	}
+}
+
+// setsEmptyID returns whether the specified block contains a call to d.SetId("").
+func (t *translator) setsEmptyID(block *ast.BlockStmt) bool {
+	for _, stmt := range block.List {
+		if stmt, ok := stmt.(*ast.ExprStmt); ok {
+			if call, ok := stmt.X.(*ast.CallExpr); ok && t.isDCall(call, "SetId") && len(call.Args) == 1 && t.source(call.Args[0]) == `""` {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// setID emits the Plugin Framework code for d.SetId(v).
+func (t *translator) setID(stmt ast.Stmt, v ast.Expr) {
+	if t.source(v) == `""` {
+		return
+	}
+
+	v = t.expr(v)
+
+	if t.hasSDKReferences(v) {
+		t.comment(stmt)
+		return
+	}
+
+	// d.SetId(aws.ToString(v))
+	if call, ok := v.(*ast.CallExpr); ok && len(call.Args) == 1 {
+		if fun := t.print(call.Fun); fun == "aws.ToString" || fun == "aws.StringValue" {
+			t.useImport(importPathFWFlex, "fwflex")
+			t.useImports(call.Args[0])
+			t.printf("%s.ID = fwflex.StringToFramework(ctx, %s)\n", t.dataVar, t.print(call.Args[0]))
+			return
+		}
+	}
+
+	t.useImport(importPathTFTypes, "")
+	t.printf("%s.ID = types.StringValue(%s)\n", t.dataVar, t.print(v))
+}
+
+// set emits the Plugin Framework code for d.Set(name, v).
+// Values that line up with the flatten source are flattened by AutoFlex.
+func (t *translator) set(stmt ast.Stmt, name, v ast.Expr) {
+	if attributeName, ok := t.attributeName(name); ok && t.flattenSource != "" {
+		if source, ok := t.flattenSourceOf(attributeName, v); ok && source == t.flattenSource {
+			if !t.flattened {
+				t.flattened = true
+				t.useImport(importPathFWFlex, "fwflex")
+				t.printf("response.Diagnostics.Append(fwflex.Flatten(ctx, %s, &%s)...)\n", t.flattenSource, t.dataVar)
+				t.printf("if response.Diagnostics.HasError() {\nreturn\n}\n")
+			}
+			return
+		}
+	}
+
+	t.comment(stmt)
+}
+
+// findFlattenSource returns the expression that most of the handler's d.Set calls take values from, if any.
+func (t *translator) findFlattenSource(body *ast.BlockStmt) string {
+	counts := make(map[string]int)
+	var sources []string
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && t.isDCall(call, "Set") && len(call.Args) == 2 {
+			if attributeName, ok := t.attributeName(call.Args[0]); ok {
+				if source, ok := t.flattenSourceOf(attributeName, call.Args[1]); ok {
+					if counts[source] == 0 {
+						sources = append(sources, source)
+					}
+					counts[source]++
+				}
+			}
+		}
+		return true
+	})
+
+	var source string
+	for _, v := range sources {
+		if counts[v] > counts[source] {
+			source = v
+		}
+	}
+
+	return source
+}
+
+// flattenSourceOf returns the source expression of a d.Set value if the value's field name lines up with the attribute name.
+// For example, d.Set("name", aws.ToString(output.Name)) has source `output`.
+func (t *translator) flattenSourceOf(attributeName string, v ast.Expr) (string, bool) {
+	for {
+		switch e := v.(type) {
+		case *ast.CallExpr:
+			if len(e.Args) != 1 {
+				return "", false
+			}
+			v = e.Args[0]
+			continue
+
+		case *ast.StarExpr:
+			v = e.X
+			continue
+
+		case *ast.SelectorExpr:
+			if !strings.EqualFold(e.Sel.Name, naming.ToCamelCase(attributeName)) {
+				return "", false
+			}
+
+			source := t.source(e.X)
+			if _, ok := t.imports[source]; ok || t.hasSDKReferences(e.X) {
+				return "", false
+			}
+
+			return source, true
+		}
+
+		return "", false
+	}
+}
+
+// input emits the Plugin Framework code for an API input struct literal assignment.
+// Fields set from attributes whose names line up with the struct field names are expanded by AutoFlex.
+// Returns false if the assignment is not an input struct literal or no fields line up.
+func (t *translator) input(stmt *ast.AssignStmt, rest []ast.Stmt) bool {
+	if len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 || stmt.Tok != token.DEFINE {
+		return false
+	}
+
+	ident, ok := stmt.Lhs[0].(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	unary, ok := stmt.Rhs[0].(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return false
+	}
+
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	if typ, ok := lit.Type.(*ast.SelectorExpr); !ok || !strings.HasSuffix(typ.Sel.Name, "Input") {
+		return false
+	}
+
+	var mapped int
+	var additional []*ast.KeyValueExpr
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return false
+		}
+
+		if attributeName, ok := t.getAttributeName(kv.Value); ok && strings.EqualFold(naming.ToCamelCase(attributeName), t.source(kv.Key)) {
+			mapped++
+			continue
+		}
+
+		additional = append(additional, kv)
+	}
+
+	// if v, ok := d.GetOk("name"); ok {
+	//   input.Name = ...
+	// }
+	for _, stmt := range rest {
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Init == nil || ifStmt.Else != nil {
+			continue
+		}
+
+		init, ok := ifStmt.Init.(*ast.AssignStmt)
+		if !ok || len(init.Rhs) != 1 {
+			continue
+		}
+
+		attributeName, ok := t.getAttributeName(init.Rhs[0])
+		if !ok {
+			continue
+		}
+
+		lineUp := len(ifStmt.Body.List) > 0
+		for _, stmt := range ifStmt.Body.List {
+			assign, ok := stmt.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != 1 {
+				lineUp = false
+				break
+			}
+			sel, ok := assign.Lhs[0].(*ast.SelectorExpr)
+			if !ok || t.source(sel.X) != ident.Name || !strings.EqualFold(sel.Sel.Name, naming.ToCamelCase(attributeName)) {
+				lineUp = false
+				break
+			}
+		}
+
+		if lineUp {
+			t.skip[stmt] = true
+			mapped++
+		}
+	}
+
+	if mapped == 0 {
+		return false
+	}
+
+	lit.Elts = nil
+	t.rewrite(stmt)
+
+	if t.hasSDKReferences(stmt) {
+		return false
+	}
+
+	t.useImport(importPathFWFlex, "fwflex")
+	t.printf("%s\n", t.print(stmt))
+	t.printf("response.Diagnostics.Append(fwflex.Expand(ctx, %s, %s)...)\n", t.dataVar, ident.Name)
+	t.printf("if response.Diagnostics.HasError() {\nreturn\n}\n")
+
+	if len(additional) > 0 {
+		t.printf("\n")
+		t.commentf("Additional fields.")
+	}
+
+	for _, kv := range additional {
+		src := t.source(kv.Value)
+		v := t.expr(kv.Value)
+
+		if t.hasSDKReferences(v) {
+			t.commentf("TODO %s.%s = %s", ident.Name, t.source(kv.Key), src)
+			continue
+		}
+
+		t.useImports(v)
+		t.printf("%s.%s = %s\n", ident.Name, t.source(kv.Key), t.print(v))
+	}
+
+	return true
+}
+
+// getAttributeName returns the name of the attribute read by a d.Get or d.GetOk call in the specified expression.
+func (t *translator) getAttributeName(e ast.Expr) (string, bool) {
+	var name string
+	var count int
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && (t.isDCall(call, "Get") || t.isDCall(call, "GetOk")) && len(call.Args) == 1 {
+			if v, ok := t.attributeName(call.Args[0]); ok {
+				name = v
+				count++
+			}
+		}
+		return true
+	})
+
+	return name, count == 1
+}
+
+// isFinalReturn returns whether the specified return statement ends the handler successfully.
+func (t *translator) isFinalReturn(stmt *ast.ReturnStmt) bool {
+	if len(stmt.Results) == 0 {
+		return true
+	}
+
+	if len(stmt.Results) != 1 {
+		return false
+	}
+
+	switch v := stmt.Results[0].(type) {
+	case *ast.Ident:
+		return v.Name == "nil" || (t.diags != "" && v.Name == t.diags)
+
+	case *ast.CallExpr:
+		// return resourceRead(ctx, d, meta)
+		// return append(diags, resourceRead(ctx, d, meta)...)
+		if fun, ok := v.Fun.(*ast.Ident); ok {
+			if fun.Name == t.readFunc {
+				return true
+			}
+			if fun.Name == "append" && len(v.Args) == 2 && v.Ellipsis.IsValid() {
+				if call, ok := v.Args[1].(*ast.CallExpr); ok {
+					if fun, ok := call.Fun.(*ast.Ident); ok && fun.Name == t.readFunc {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// returnStmt emits the Plugin Framework code for a return statement.
+func (t *translator) returnStmt(stmt *ast.ReturnStmt) {
+	if t.isFinalReturn(stmt) {
+		if t.op != "Delete" && len(stmt.Results) == 1 && t.source(stmt.Results[0]) != "nil" && t.source(stmt.Results[0]) != t.diags {
+			t.printf("response.Diagnostics.Append(response.State.Set(ctx, &%s)...)\n", t.dataVar)
+		}
+		t.printf("return\n")
+		return
+	}
+
+	if len(stmt.Results) == 1 {
+		if call, ok := stmt.Results[0].(*ast.CallExpr); ok {
+			if summary, detail, ok := t.diagError(call); ok {
+				t.printf("response.Diagnostics.AddError(%s, %s)\n\nreturn\n", summary, detail)
+				return
+			}
+		}
+	}
+
+	t.comment(stmt)
+}
+
+// diagError returns the Plugin Framework error diagnostic summary and detail for a Plugin SDK error diagnostic expression.
+func (t *translator) diagError(call *ast.CallExpr) (string, string, bool) {
+	args := call.Args
+
+	switch fun := t.source(call.Fun); fun {
+	case "sdkdiag.AppendErrorf", "diag.Errorf":
+		if fun == "sdkdiag.AppendErrorf" {
+			if len(args) < 2 {
+				return "", "", false
+			}
+			args = args[1:]
+		}
+
+		if len(args) < 1 {
+			return "", "", false
+		}
+
+		lit, ok := args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return "", "", false
+		}
+
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", "", false
+		}
+
+		args = args[1:]
+		for i, v := range args {
+			args[i] = t.expr(v)
+			if t.hasSDKReferences(args[i]) {
+				return "", "", false
+			}
+			t.useImports(args[i])
+		}
+
+		detail := `""`
+		if len(args) > 0 && (strings.HasSuffix(format, ": %s") || strings.HasSuffix(format, ": %w")) {
+			format = format[:len(format)-len(": %s")]
+			detail = t.print(args[len(args)-1]) + ".Error()"
+			args = args[:len(args)-1]
+		}
+
+		if len(args) == 0 {
+			return strconv.Quote(format), detail, true
+		}
+
+		var printed []string
+		for _, v := range args {
+			printed = append(printed, t.print(v))
+		}
+
+		t.useImport(importPathFmt, "")
+
+		return fmt.Sprintf("fmt.Sprintf(%s, %s)", strconv.Quote(format), strings.Join(printed, ", ")), detail, true
+
+	case "sdkdiag.AppendFromErr", "diag.FromErr":
+		if fun == "sdkdiag.AppendFromErr" {
+			if len(args) != 2 {
+				return "", "", false
+			}
+			args = args[1:]
+		}
+
+		if len(args) != 1 {
+			return "", "", false
+		}
+
+		err := t.expr(args[0])
+		if t.hasSDKReferences(err) {
+			return "", "", false
+		}
+		t.useImports(err)
+
+		return strconv.Quote(fmt.Sprintf("%s %s", operationVerb(t.op), t.tfTypeName)), t.print(err) + ".Error()", true
+
+	case "create.DiagError", "create.AppendDiagError":
+		if fun == "create.AppendDiagError" {
+			if len(args) != 6 {
+				return "", "", false
+			}
+			args = args[1:]
+		}
+
+		if len(args) != 5 {
+			return "", "", false
+		}
+
+		var printed []string
+		for i, v := range args {
+			args[i] = t.expr(v)
+			if t.hasSDKReferences(args[i]) {
+				return "", "", false
+			}
+			t.useImports(args[i])
+			printed = append(printed, t.print(args[i]))
+		}
+
+		return fmt.Sprintf("create.ProblemStandardMessage(%s, nil)", strings.Join(printed[:4], ", ")), printed[4] + ".Error()", true
+	}
+
+	return "", "", false
+}
+
+func operationVerb(op string) string {
+	switch op {
+	case "Create":
+		return "creating"
+	case "Read":
+		return "reading"
+	case "Update":
+		return "updating"
+	case "Delete":
+		return "deleting"
+	}
+
+	return op
+}
+
+// rewrite rewrites any Plugin SDK expressions in the specified statement.
+func (t *translator) rewrite(stmt ast.Stmt) {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		for i, v := range stmt.Lhs {
+			stmt.Lhs[i] = t.expr(v)
+		}
+		// v, ok := d.Get("name").(string)
+		if v, ok := stmt.Rhs[0].(*ast.TypeAssertExpr); ok && len(stmt.Lhs) == 2 && len(stmt.Rhs) == 1 {
+			v.X = t.expr(v.X)
+			return
+		}
+		for i, v := range stmt.Rhs {
+			stmt.Rhs[i] = t.expr(v)
+		}
+	case *ast.BlockStmt:
+		for _, v := range stmt.List {
+			t.rewrite(v)
+		}
+	case *ast.CaseClause:
+		for i, v := range stmt.List {
+			stmt.List[i] = t.expr(v)
+		}
+		for _, v := range stmt.Body {
+			t.rewrite(v)
+		}
+	case *ast.DeclStmt:
+		if decl, ok := stmt.Decl.(*ast.GenDecl); ok {
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.ValueSpec); ok {
+					for i, v := range spec.Values {
+						spec.Values[i] = t.expr(v)
+					}
+				}
+			}
+		}
+	case *ast.DeferStmt:
+		stmt.Call = t.expr(stmt.Call).(*ast.CallExpr)
+	case *ast.ExprStmt:
+		stmt.X = t.expr(stmt.X)
+	case *ast.ForStmt:
+		if stmt.Init != nil {
+			t.rewrite(stmt.Init)
+		}
+		if stmt.Cond != nil {
+			stmt.Cond = t.expr(stmt.Cond)
+		}
+		if stmt.Post != nil {
+			t.rewrite(stmt.Post)
+		}
+		t.rewrite(stmt.Body)
+	case *ast.GoStmt:
+		stmt.Call = t.expr(stmt.Call).(*ast.CallExpr)
+	case *ast.IfStmt:
+		if stmt.Init != nil {
+			t.rewrite(stmt.Init)
+		}
+		stmt.Cond = t.expr(stmt.Cond)
+		t.rewrite(stmt.Body)
+		if stmt.Else != nil {
+			t.rewrite(stmt.Else)
+		}
+	case *ast.IncDecStmt:
+		stmt.X = t.expr(stmt.X)
+	case *ast.RangeStmt:
+		stmt.X = t.expr(stmt.X)
+		t.rewrite(stmt.Body)
+	case *ast.ReturnStmt:
+		for i, v := range stmt.Results {
+			stmt.Results[i] = t.expr(v)
+		}
+	case *ast.SendStmt:
+		stmt.Chan = t.expr(stmt.Chan)
+		stmt.Value = t.expr(stmt.Value)
+	case *ast.SwitchStmt:
+		if stmt.Init != nil {
+			t.rewrite(stmt.Init)
+		}
+		if stmt.Tag != nil {
+			stmt.Tag = t.expr(stmt.Tag)
+		}
+		t.rewrite(stmt.Body)
+	case *ast.TypeSwitchStmt:
+		if stmt.Init != nil {
+			t.rewrite(stmt.Init)
+		}
+		t.rewrite(stmt.Assign)
+		t.rewrite(stmt.Body)
+	}
+}
+
+// expr returns the specified expression with any Plugin SDK expressions rewritten.
+func (t *translator) expr(e ast.Expr) ast.Expr {
+	switch e := e.(type) {
+	case *ast.BinaryExpr:
+		// !d.IsNewResource() && ...
+		if e.Op == token.LAND {
+			if t.isNotNewResource(e.X) {
+				return t.expr(e.Y)
+			}
+			if t.isNotNewResource(e.Y) {
+				return t.expr(e.X)
+			}
+		}
+		e.X = t.expr(e.X)
+		e.Y = t.expr(e.Y)
+
+	case *ast.CallExpr:
+		if v := t.call(e); v != nil {
+			return v
+		}
+		e.Fun = t.expr(e.Fun)
+		for i, v := range e.Args {
+			e.Args[i] = t.expr(v)
+		}
+
+	case *ast.CompositeLit:
+		for i, v := range e.Elts {
+			e.Elts[i] = t.expr(v)
+		}
+
+	case *ast.FuncLit:
+		t.rewrite(e.Body)
+
+	case *ast.IndexExpr:
+		e.X = t.expr(e.X)
+		e.Index = t.expr(e.Index)
+
+	case *ast.KeyValueExpr:
+		e.Value = t.expr(e.Value)
+
+	case *ast.ParenExpr:
+		e.X = t.expr(e.X)
+
+	case *ast.SelectorExpr:
+		e.X = t.expr(e.X)
+
+	case *ast.SliceExpr:
+		e.X = t.expr(e.X)
+
+	case *ast.StarExpr:
+		e.X = t.expr(e.X)
+
+	case *ast.TypeAssertExpr:
+		// d.Get("name").(string)
+		if call, ok := e.X.(*ast.CallExpr); ok && t.isDCall(call, "Get") && len(call.Args) == 1 {
+			if v := t.getValue(call.Args[0], t.source(e.Type)); v != nil {
+				return v
+			}
+		}
+
+		// meta.(*conns.AWSClient)
+		if v, ok := e.X.(*ast.Ident); ok && v.Name == t.meta && t.source(e.Type) == "*conns.AWSClient" {
+			v := mustParseExpr(t.receiver + ".Meta()")
+			t.generated[v.(*ast.CallExpr).Fun.(*ast.SelectorExpr).X.(*ast.Ident)] = true
+
+			return v
+		}
+
+		e.X = t.expr(e.X)
+
+	case *ast.UnaryExpr:
+		e.X = t.expr(e.X)
+	}
+
+	return e
+}
+
+// call returns the rewritten Plugin SDK call expression, or nil if the call is not rewritten.
+func (t *translator) call(call *ast.CallExpr) ast.Expr {
+	switch {
+	case t.isDCall(call, "Id") && len(call.Args) == 0:
+		return mustParseExpr(t.dataVar + ".ID.ValueString()")
+
+	case t.isDCall(call, "Timeout") && len(call.Args) == 1 && t.hasTimeouts:
+		switch t.source(call.Args[0]) {
+		case "schema.TimeoutCreate":
+			return mustParseExpr(fmt.Sprintf("r.CreateTimeout(ctx, %s.Timeouts)", t.dataVar))
+		case "schema.TimeoutRead":
+			return mustParseExpr(fmt.Sprintf("r.ReadTimeout(ctx, %s.Timeouts)", t.dataVar))
+		case "schema.TimeoutUpdate":
+			return mustParseExpr(fmt.Sprintf("r.UpdateTimeout(ctx, %s.Timeouts)", t.dataVar))
+		case "schema.TimeoutDelete":
+			return mustParseExpr(fmt.Sprintf("r.DeleteTimeout(ctx, %s.Timeouts)", t.dataVar))
+		}
+
+	case t.op == "Update" && (t.isDCall(call, "HasChange") || t.isDCall(call, "HasChanges")):
+		var names []string
+		for _, v := range call.Args {
+			name, ok := t.attributeName(v)
+			if !ok {
+				return nil
+			}
+			names = append(names, name)
+		}
+
+		return t.hasChange(names)
+
+	case t.op == "Update" && t.isDCall(call, "HasChangesExcept"):
+		except := make(map[string]bool)
+		for _, v := range call.Args {
+			name, ok := t.attributeName(v)
+			if !ok {
+				return nil
+			}
+			except[name] = true
+		}
+
+		var names []string
+		for name, v := range t.attributes {
+			if !except[name] && name != "id" && (v.Required || v.Optional) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		return t.hasChange(names)
+	}
+
+	return nil
+}
+
+// hasChange returns an expression that is true if any of the specified attributes' planned values differ from their prior state values.
+func (t *translator) hasChange(names []string) ast.Expr {
+	var exprs []string
+
+	for _, name := range names {
+		field, ok := t.fields[name]
+		if !ok {
+			return nil
+		}
+		exprs = append(exprs, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", field.GoName))
+	}
+
+	if len(exprs) == 0 {
+		return nil
+	}
+
+	return mustParseExpr(strings.Join(exprs, " || "))
+}
+
+// getValue returns the model value expression equivalent to d.Get(name).(typ).
+func (t *translator) getValue(name ast.Expr, typ string) ast.Expr {
+	attributeName, ok := t.attributeName(name)
+	if !ok {
+		return nil
+	}
+
+	attribute, field := t.attributes[attributeName], t.fields[attributeName]
+	if attribute == nil || field.GoName == "" {
+		return nil
+	}
+
+	var format string
+	switch {
+	case attribute.Type == schema.TypeString && typ == "string":
+		format = "%s.%s.ValueString()"
+	case attribute.Type == schema.TypeBool && typ == "bool":
+		format = "%s.%s.ValueBool()"
+	case attribute.Type == schema.TypeInt && typ == "int":
+		format = "int(%s.%s.ValueInt64())"
+	case attribute.Type == schema.TypeFloat && typ == "float64":
+		format = "%s.%s.ValueFloat64()"
+	default:
+		return nil
+	}
+
+	return mustParseExpr(fmt.Sprintf(format, t.dataVar, field.GoName))
+}
+
+// attributeName returns the attribute name specified by a string literal or names.Attr constant.
+func (t *translator) attributeName(e ast.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+
+		name, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return "", false
+		}
+
+		return name, true
+
+	case *ast.SelectorExpr:
+		// names.AttrARN
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "names" && strings.HasPrefix(e.Sel.Name, "Attr") {
+			for name := range t.attributes {
+				if naming.ToCamelCase(name) == strings.TrimPrefix(e.Sel.Name, "Attr") {
+					return name, true
+				}
+			}
+		}
+	}
+
+	return "", false
+}
+
+// isDCall returns whether the specified call expression is a call to the named *schema.ResourceData method.
+func (t *translator) isDCall(call *ast.CallExpr, method string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != method {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+
+	return ok && t.d != "" && x.Name == t.d
+}
+
+// isNotNewResource returns whether the specified expression is !d.IsNewResource().
+func (t *translator) isNotNewResource(e ast.Expr) bool {
+	unary, ok := e.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.NOT {
+		return false
+	}
+
+	call, ok := unary.X.(*ast.CallExpr)
+
+	return ok && t.isDCall(call, "IsNewResource")
+}
+
+// hasSDKReferences returns whether the specified node still references Plugin SDK values or packages.
+func (t *translator) hasSDKReferences(n ast.Node) bool {
+	found := false
+
+	var inspect func(n ast.Node) bool
+	inspect = func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(n.X, inspect)
+			return false
+
+		case *ast.KeyValueExpr:
+			ast.Inspect(n.Value, inspect)
+			return false
+
+		case *ast.Ident:
+			if t.generated[n] {
+				return false
+			}
+
+			switch n.Name {
+			case t.d, t.meta, t.diags:
+				found = n.Name != ""
+				return false
+			}
+
+			if importPath, ok := t.sourceFile.imports[n.Name]; ok && n.Obj == nil {
+				for _, v := range sdkImportPaths {
+					if importPath == v {
+						found = true
+						return false
+					}
+				}
+			}
+		}
+
+		return true
+	}
+
+	ast.Inspect(n, inspect)
+
+	return found
+}
+
+// print returns the Go source for the specified node, recording any imports used.
+func (t *translator) print(n ast.Node) string {
+	t.useImports(n)
+
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, t.fset, n); err != nil {
+		return t.source(n)
+	}
+
+	return buf.String()
+}
+
+// useImports records the imports of any packages referenced by the specified node.
+// References to AWS SDK for Go v2 `types` packages are renamed to `awstypes`.
+func (t *translator) useImports(n ast.Node) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Obj == nil {
+				if importPath, ok := t.sourceFile.imports[x.Name]; ok {
+					name := x.Name
+					if name == "types" && importPath != importPathTFTypes {
+						x.Name = "awstypes"
+						name = "awstypes"
+					}
+					t.useImport(importPath, name)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// useImport records an import used by the translated code.
+func (t *translator) useImport(importPath, name string) {
+	if name == path.Base(importPath) {
+		name = ""
+	}
+
+	t.imports[importPath] = name
+}
+
+// mustParseExpr parses a Go expression.
+// The expression has no source positions so that it prints correctly within translated statements.
+func mustParseExpr(x string) ast.Expr {
+	e, err := parser.ParseExpr(x)
+
+	if err != nil {
+		panic(err)
+	}
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if n != nil {
+			clearPos(reflect.ValueOf(n).Elem())
+		}
+		return true
+	})
+
+	return e
+}
+
+// clearPos zeroes any token.Pos fields of the specified AST node struct.
+func clearPos(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Type() == reflect.TypeOf(token.NoPos) {
+			f.SetInt(int64(token.NoPos))
+		}
+	}
+}
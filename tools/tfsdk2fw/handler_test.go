@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTranslator(t *testing.T) {
+	t.Parallel()
+
+	source, err := newSourceResource("testdata/example", "aws_example_widget", false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := source.factory, "resourceWidget"; got != expected {
+		t.Errorf("factory: got %s, expected %s", got, expected)
+	}
+
+	attributes := map[string]*schema.Schema{
+		"arn":         {Type: schema.TypeString, Computed: true},
+		"description": {Type: schema.TypeString, Optional: true},
+		"id":          {Type: schema.TypeString, Computed: true},
+		"name":        {Type: schema.TypeString, Required: true},
+		"size":        {Type: schema.TypeInt, Optional: true},
+	}
+	fields := []modelField{
+		{GoName: "ARN", GoType: "types.String", Name: "arn"},
+		{GoName: "Description", GoType: "types.String", Name: "description"},
+		{GoName: "ID", GoType: "types.String", Name: "id"},
+		{GoName: "Name", GoType: "types.String", Name: "name"},
+		{GoName: "Size", GoType: "types.Int64", Name: "size"},
+	}
+
+	testCases := map[string]string{
+		"Create": `
+	conn := r.Meta().ExampleClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &example.CreateWidgetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(name)
+
+	output, err := conn.CreateWidget(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Example Widget (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.WidgetId)
+
+	// TODO Set values for any unknown attributes.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+`,
+		"Read": `
+	conn := r.Meta().ExampleClient(ctx)
+
+	widget, err := findWidgetByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Example Widget (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, widget, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// TODO d.Set("size", widget.Capacity)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+`,
+		"Update": `
+	conn := r.Meta().ExampleClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Size.Equal(old.Size) {
+		input := &example.UpdateWidgetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.WidgetId = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateWidget(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Example Widget (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+`,
+		"Delete": `
+	conn := r.Meta().ExampleClient(ctx)
+
+	_, err := conn.DeleteWidget(ctx, &example.DeleteWidgetInput{
+		WidgetId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Example Widget (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+`,
+	}
+
+	imports := make(map[string]string)
+
+	for _, op := range []string{"Create", "Read", "Update", "Delete"} {
+		decl, f := source.handler(op)
+
+		if decl == nil {
+			t.Fatalf("%s handler not found", op)
+		}
+
+		got := formatBody(t, newTranslator(f, attributes, fields, false, "r", source.handlers["Read"], "aws_example_widget", imports).translate(op, decl))
+
+		if diff := cmp.Diff(got, formatBody(t, testCases[op])); diff != "" {
+			t.Errorf("%s: unexpected diff (+wanted, -got): %s", op, diff)
+		}
+	}
+
+	expectedImports := map[string]string{
+		"fmt":                              "",
+		"github.com/aws/aws-sdk-go-v2/aws": "",
+		"github.com/aws/aws-sdk-go-v2/service/example":                        "",
+		"github.com/aws/aws-sdk-go-v2/service/example/types":                  "awstypes",
+		"github.com/hashicorp/terraform-provider-aws/internal/errs":           "",
+		"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag":    "",
+		"github.com/hashicorp/terraform-provider-aws/internal/framework/flex": "fwflex",
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource":     "",
+	}
+
+	if diff := cmp.Diff(imports, expectedImports); diff != "" {
+		t.Errorf("imports: unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewSourceResourceSharedTypeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		isDataSource     bool
+		expectedFactory  string
+		expectedHandlers map[string]string
+	}{
+		"resource": {
+			expectedFactory: "resourceWidget",
+			expectedHandlers: map[string]string{
+				"Create": "resourceWidgetCreate",
+				"Read":   "resourceWidgetRead",
+				"Update": "resourceWidgetUpdate",
+				"Delete": "resourceWidgetDelete",
+			},
+		},
+		"data source": {
+			isDataSource:    true,
+			expectedFactory: "dataSourceWidget",
+			expectedHandlers: map[string]string{
+				"Read": "dataSourceWidgetRead",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := newSourceResource("testdata/example", "aws_example_widget", testCase.isDataSource)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, expected := source.factory, testCase.expectedFactory; got != expected {
+				t.Errorf("factory: got %s, expected %s", got, expected)
+			}
+
+			if diff := cmp.Diff(source.handlers, testCase.expectedHandlers); diff != "" {
+				t.Errorf("handlers: unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// formatBody returns the formatted source of a function body.
+func formatBody(t *testing.T, body string) string {
+	t.Helper()
+
+	b, err := format.Source([]byte("package main\n\nfunc _() {\n" + strings.Trim(body, "\n") + "\n}\n"))
+
+	if err != nil {
+		t.Fatalf("formatting %q: %s", body, err)
+	}
+
+	return string(b)
+}
@@ -11,12 +11,18 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -87,6 +93,12 @@ func main() {
 		migrator.TFTypeName = v
 	}
 
+	// The provider injects a top-level `region` attribute into regional resources and data sources.
+	// The Plugin Framework provider does the same for migrated resources and data sources.
+	if v, ok := migrator.Resource.Schema[names.AttrRegion]; ok && !names.IsGlobalService(packageName) && reflect.ValueOf(v.ValidateFunc).Pointer() == reflect.ValueOf(verify.ValidRegionName).Pointer() {
+		delete(migrator.Resource.Schema, names.AttrRegion)
+	}
+
 	if err := migrator.migrate(outputFilename); err != nil {
 		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
 	}
@@ -126,17 +138,39 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if templateData.SDKFactory == "" {
+		m.Generator.Warnf("Plugin SDK factory function not found, no schema equivalence test generated")
+
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_schema_test.go"
+
+	m.infof("generating schema equivalence test into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("schematest", schemaTestImpl, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbNestedStructs := strings.Builder{}
 	emitter := &emitter{
-		Generator:    m.Generator,
-		IsDataSource: m.IsDataSource,
-		SchemaWriter: &sbSchema,
-		StructWriter: &sbStruct,
+		Generator:          m.Generator,
+		IsDataSource:       m.IsDataSource,
+		NestedStructWriter: &sbNestedStructs,
+		SchemaWriter:       &sbSchema,
+		StructWriter:       &sbStruct,
 	}
 
 	err := emitter.emitSchemaForResource(m.Resource)
@@ -145,6 +179,16 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	var stateUpgraders []stateUpgraderData
+
+	if !m.IsDataSource {
+		stateUpgraders, err = emitter.emitStateUpgraders(m.Resource)
+
+		if err != nil {
+			return nil, fmt.Errorf("emitting state upgrader code: %w", err)
+		}
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -156,13 +200,25 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportTimeouts:               emitter.HasTimeouts || emitter.HasPriorTimeouts,
+		IsDataSource:                 m.IsDataSource,
+		LowerName:                    strings.ToLower(m.Name[:1]) + m.Name[1:],
 		Name:                         m.Name,
+		NestedStructs:                sbNestedStructs.String(),
 		PackageName:                  m.PackageName,
+		SchemaVersion:                m.Resource.SchemaVersion,
 		Schema:                       sbSchema.String(),
+		StateUpgraders:               stateUpgraders,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if err := m.translateHandlers(templateData, emitter); err != nil {
+		m.Generator.Warnf("Plugin SDK CRUD handlers not translated: %s", err)
+	}
+
+	templateData.ImportTFLog = templateData.ImportTFLog || (!m.IsDataSource && templateData.Delete == "")
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -182,29 +238,143 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// translateHandlers translates the bodies of the Plugin SDK CRUD handler functions into Plugin Framework code.
+// Any imports required by the translated code are added to the template data.
+func (m *migrator) translateHandlers(templateData *templateData, emitter *emitter) error {
+	// Service package source is located relative to this tool's source.
+	_, filename, _, _ := runtime.Caller(0)
+	dirname := path.Join(path.Dir(filename), "..", "..", "internal", "service", m.PackageName)
+
+	source, err := newSourceResource(dirname, m.TFTypeName, m.IsDataSource)
+
+	if err != nil {
+		return err
+	}
+
+	templateData.SDKFactory = source.factory
+
+	receiver := "r"
+	if m.IsDataSource {
+		receiver = "d"
+	}
+
+	imports := make(map[string]string)
+
+	for _, op := range []string{"Create", "Read", "Update", "Delete"} {
+		decl, f := source.handler(op)
+
+		if decl == nil || decl.Body == nil {
+			continue
+		}
+
+		translator := newTranslator(f, m.Resource.Schema, emitter.Fields, emitter.HasTimeouts, receiver, source.handlers["Read"], m.TFTypeName, imports)
+		body := translator.translate(op, decl)
+
+		switch op {
+		case "Create":
+			templateData.Create = body
+		case "Read":
+			templateData.Read = body
+		case "Update":
+			templateData.Update = body
+		case "Delete":
+			templateData.Delete = body
+		}
+	}
+
+	for importPath, name := range imports {
+		// Imports already handled by the template.
+		switch importPath {
+		case "context",
+			"github.com/hashicorp/terraform-plugin-framework/datasource",
+			"github.com/hashicorp/terraform-plugin-framework/resource",
+			"github.com/hashicorp/terraform-provider-aws/internal/framework",
+			importPathTFTypes:
+			continue
+		case "time":
+			if templateData.HasTimeouts {
+				continue
+			}
+		case importPathTimeouts:
+			templateData.ImportTimeouts = true
+			continue
+		case importPathFWTypes:
+			templateData.ImportProviderFrameworkTypes = true
+			continue
+		case "github.com/hashicorp/terraform-plugin-log/tflog":
+			templateData.ImportTFLog = true
+			continue
+		}
+
+		spec := strconv.Quote(importPath)
+		if name != "" {
+			spec = name + " " + spec
+		}
+
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			templateData.Imports = append(templateData.Imports, spec)
+		} else {
+			templateData.StdImports = append(templateData.StdImports, spec)
+		}
+	}
+
+	sort.Strings(templateData.Imports)
+	sort.Strings(templateData.StdImports)
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          goDuration
+	DefaultReadTimeout            goDuration
+	DefaultUpdateTimeout          goDuration
+	DefaultDeleteTimeout          goDuration
+	Fields                        []modelField // Top-level model struct fields.
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
+	HasPriorTimeouts              bool     // Whether any prior schema has a timeouts block.
 	HasTimeouts                   bool
 	HasTopLevelTagsAllMap         bool
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	NestedStructWriter            io.Writer
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
 
+// modelField describes a model struct field.
+type modelField struct {
+	GoName string // e.g. SubnetIDs
+	GoType string // e.g. fwtypes.SetValueOf[types.String]
+	Name   string // e.g. subnet_ids
+}
+
+// goDuration is a time.Duration that formats as a Go expression.
+type goDuration time.Duration
+
+func (d goDuration) String() string {
+	v := time.Duration(d)
+
+	switch {
+	case v%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", v/time.Hour)
+	case v%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", v/time.Minute)
+	case v%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", v/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", v)
+	}
+}
+
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
 func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 	if _, ok := resource.Schema["id"]; ok {
@@ -221,16 +391,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = goDuration(*v)
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = goDuration(*v)
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = goDuration(*v)
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = goDuration(*v)
 		}
 	}
 
@@ -287,19 +457,13 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
-		err := e.emitAttributeProperty(append(path, name), property)
+		goType, err := e.emitAttributeProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		e.emitStructField(name, goType, isTopLevelAttribute)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -322,12 +486,14 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		goType, err := e.emitBlockProperty(append(path, name), property)
 
 		if err != nil {
 			return err
 		}
 
+		e.emitStructField(name, goType, isTopLevelAttribute)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -339,10 +505,11 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) (string, error) {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
 	isTopLevelAttribute := len(path) == 1
+	var goType string
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType, providerPlanModifierPackage string
 
@@ -354,9 +521,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		goType = "types.Bool"
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +529,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		goType = "types.Float64"
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +537,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		goType = "types.Int64"
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +550,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			goType = "fwtypes.ARN"
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +558,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			goType = "types.String"
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +575,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			goType = "types.List"
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +586,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			goType = "types.Map"
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +597,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			goType = "types.Set"
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -456,7 +607,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
+			var customType, elementType string
 
 			switch v := v.Type; v {
 			case schema.TypeBool:
@@ -471,7 +622,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			case schema.TypeString:
 				elementType = "types.StringType"
 				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
+				if typeName == "map" && isTopLevelAttribute && (attributeName == "tags" || attributeName == "tags_all") {
 					if attributeName == "tags" {
 						e.HasTopLevelTagsMap = true
 						if property.Optional {
@@ -483,13 +634,21 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 						e.HasTopLevelTagsAllMap = true
 						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
 					}
+				} else {
+					// Collections of strings are handled by AutoFlex via custom types.
+					e.ImportProviderFrameworkTypes = true
+					customType = fmt.Sprintf("fwtypes.%sOfStringType", fwPlanModifierType)
+					goType = fmt.Sprintf("fwtypes.%sValueOf[types.String]", fwPlanModifierType)
 				}
 
 			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+				return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			if customType != "" {
+				fprintf(e.SchemaWriter, "CustomType:%s,\n", customType)
+			}
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
@@ -498,17 +657,17 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "ElementType:")
 
 			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
+				return "", err
 			}
 
 			fprintf(e.SchemaWriter, ",\n")
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	if property.Required {
@@ -592,12 +751,13 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) (string, error) {
+	var goType string
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -614,19 +774,17 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			modelName, err := e.emitNestedObjectModel(path, v.Schema, "schema.ListNestedBlock{\n")
 
 			if err != nil {
-				return err
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
+			goType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
 		}
 
 	case schema.TypeSet:
@@ -637,23 +795,21 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			modelName, err := e.emitNestedObjectModel(path, v.Schema, "schema.SetNestedBlock{\n")
 
 			if err != nil {
-				return err
+				return "", err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
+			goType = fmt.Sprintf("fwtypes.SetNestedObjectValueOf[%s]", modelName)
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
+			return "", unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
 		}
 
 	default:
-		return unsupportedTypeError(path, v.String())
+		return "", unsupportedTypeError(path, v.String())
 	}
 
 	// Compatibility hacks.
@@ -709,7 +865,53 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 	fprintf(e.SchemaWriter, "}")
 
-	return nil
+	return goType, nil
+}
+
+// emitNestedObjectModel generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writer.
+// The nested object's model struct is emitted separately and its type name returned.
+func (e *emitter) emitNestedObjectModel(path []string, schema map[string]*schema.Schema, schemaFactory string) (string, error) {
+	modelName := naming.ToCamelCase(strings.Join(path, "_"))
+	modelName = strings.ToLower(modelName[:1]) + modelName[1:] + "Model"
+
+	e.ImportProviderFrameworkTypes = true
+
+	fprintf(e.SchemaWriter, schemaFactory)
+	fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+
+	structWriter := e.StructWriter
+	sbStruct := strings.Builder{}
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return "", err
+	}
+
+	fprintf(e.SchemaWriter, "},\n")
+
+	fprintf(e.NestedStructWriter, "\ntype %s struct {\n%s}\n", modelName, sbStruct.String())
+
+	return modelName, nil
+}
+
+// emitStructField emits a model struct field for an Attribute or Block.
+func (e *emitter) emitStructField(name, goType string, isTopLevel bool) {
+	goName := naming.ToCamelCase(name)
+
+	fprintf(e.StructWriter, "%s %s `tfsdk:%q`\n", goName, goType, name)
+
+	if isTopLevel {
+		e.Fields = append(e.Fields, modelField{
+			GoName: goName,
+			GoType: goType,
+			Name:   name,
+		})
+	}
 }
 
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
@@ -883,10 +1085,12 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	Create                        string // Translated Create handler body.
+	DefaultCreateTimeout          goDuration
+	DefaultReadTimeout            goDuration
+	DefaultUpdateTimeout          goDuration
+	DefaultDeleteTimeout          goDuration
+	Delete                        string // Translated Delete handler body.
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
@@ -895,12 +1099,24 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTFLog                   bool
+	ImportTimeouts                bool
+	Imports                       []string // Additional imports required by translated handlers.
+	IsDataSource                  bool
+	LowerName                     string // e.g. instance
 	Name                          string // e.g. Instance
+	NestedStructs                 string
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
+	Read                          string // Translated Read handler body.
+	SchemaVersion                 int
+	SDKFactory                    string // e.g. ResourceInstance
 	Schema                        string
+	StateUpgraders                []stateUpgraderData
+	StdImports                    []string // Additional standard library imports required by translated handlers.
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	Update                        string // Translated Update handler body.
 }
 
 //go:embed datasource.tmpl
@@ -908,3 +1124,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed schematest.tmpl
+var schemaTestImpl string
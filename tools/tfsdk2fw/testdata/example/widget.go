@@ -0,0 +1,134 @@
+package example
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/example"
+	"github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		UpdateWithoutTimeout: resourceWidgetUpdate,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &example.CreateWidgetInput{
+		ClientToken: aws.String(name),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWidget(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Example Widget (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.WidgetId))
+
+	return append(diags, resourceWidgetRead(ctx, d, meta)...)
+}
+
+func resourceWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	widget, err := findWidgetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Example Widget (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Example Widget (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, widget.Arn)
+	d.Set("description", widget.Description)
+	d.Set("name", widget.Name)
+	d.Set("size", widget.Capacity)
+
+	return diags
+}
+
+func resourceWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChanges("description", "size") {
+		input := &example.UpdateWidgetInput{
+			Size:     aws.Int32(int32(d.Get("size").(int))),
+			WidgetId: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateWidget(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Example Widget (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWidgetRead(ctx, d, meta)...)
+}
+
+func resourceWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	_, err := conn.DeleteWidget(ctx, &example.DeleteWidgetInput{
+		WidgetId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Example Widget (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+// stateUpgraderData is the template data for a Plugin Framework state upgrader.
+type stateUpgraderData struct {
+	Conversions string   // Statements converting prior state values.
+	Fields      string   // Model struct literal fields.
+	Schema      string   // Prior schema.
+	Struct      string   // Prior model struct fields.
+	UpgradeFns  []string // Names of the Plugin SDK state upgrade functions to port.
+	Version     int
+}
+
+// emitStateUpgraders generates the Plugin Framework state upgraders for a Plugin SDK Resource's StateUpgraders.
+// Plugin Framework state upgraders upgrade directly from a prior version to the current version,
+// so each upgrader corresponds to the chain of Plugin SDK upgrade functions from its version.
+func (e *emitter) emitStateUpgraders(resource *schema.Resource) ([]stateUpgraderData, error) {
+	if resource.SchemaVersion > 0 && len(resource.StateUpgraders) == 0 {
+		e.warnf("Schema version %d has no StateUpgraders", resource.SchemaVersion)
+	}
+
+	fields := make(map[string]modelField)
+	for _, v := range e.Fields {
+		fields[v.Name] = v
+	}
+	if e.HasTimeouts {
+		fields["timeouts"] = modelField{GoName: "Timeouts", GoType: "timeouts.Value", Name: "timeouts"}
+	}
+
+	var upgraders []stateUpgraderData
+
+	for i, upgrader := range resource.StateUpgraders {
+		if !upgrader.Type.IsObjectType() {
+			return nil, fmt.Errorf("state upgrader version %d: unsupported type: %s", upgrader.Version, upgrader.Type.FriendlyName())
+		}
+
+		data := stateUpgraderData{
+			Version: upgrader.Version,
+		}
+
+		for _, v := range resource.StateUpgraders[i:] {
+			if name := funcName(v.Upgrade); name != "" {
+				data.UpgradeFns = append(data.UpgradeFns, name)
+			} else {
+				data.UpgradeFns = append(data.UpgradeFns, fmt.Sprintf("(anonymous version %d upgrade function)", v.Version))
+			}
+		}
+
+		sbSchema, sbStruct, sbFields, sbConversions := strings.Builder{}, strings.Builder{}, strings.Builder{}, strings.Builder{}
+
+		attributeTypes := upgrader.Type.AttributeTypes()
+		names := make([]string, 0)
+		for name := range attributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fprintf(&sbSchema, "schema.Schema{\n")
+		fprintf(&sbSchema, "Version:%d,\n", upgrader.Version)
+		fprintf(&sbSchema, "Attributes: map[string]schema.Attribute{\n")
+
+		var timeoutNames []string
+		priorFields := make(map[string]modelField)
+
+		for _, name := range names {
+			typ := attributeTypes[name]
+			field := fields[name]
+
+			if name == "timeouts" && typ.IsObjectType() {
+				for v := range typ.AttributeTypes() {
+					timeoutNames = append(timeoutNames, v)
+				}
+				sort.Strings(timeoutNames)
+
+				priorFields[name] = modelField{GoName: "Timeouts", GoType: "timeouts.Value", Name: name}
+				continue
+			}
+
+			var attribute, goType string
+			switch {
+			case typ == cty.String:
+				attribute, goType = "schema.StringAttribute{\n", "types.String"
+
+			case typ == cty.Bool:
+				attribute, goType = "schema.BoolAttribute{\n", "types.Bool"
+
+			case typ == cty.Number:
+				switch field.GoType {
+				case "types.Int64":
+					attribute, goType = "schema.Int64Attribute{\n", "types.Int64"
+				case "types.Float64":
+					attribute, goType = "schema.Float64Attribute{\n", "types.Float64"
+				default:
+					attribute, goType = "schema.NumberAttribute{\n", "types.Number"
+				}
+
+			case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+				elementType, err := e.emitCtyType([]string{name}, typ.ElementType())
+
+				if err != nil {
+					return nil, err
+				}
+
+				switch {
+				case typ.IsListType():
+					attribute, goType = "schema.ListAttribute{\n", "types.List"
+				case typ.IsSetType():
+					attribute, goType = "schema.SetAttribute{\n", "types.Set"
+				case typ.IsMapType():
+					attribute, goType = "schema.MapAttribute{\n", "types.Map"
+				}
+				attribute += fmt.Sprintf("ElementType:%s,\n", elementType)
+
+			case typ.IsObjectType():
+				attributeTypes, err := e.emitCtyAttributeTypes([]string{name}, typ)
+
+				if err != nil {
+					return nil, err
+				}
+
+				attribute, goType = fmt.Sprintf("schema.ObjectAttribute{\nAttributeTypes:%s,\n", attributeTypes), "types.Object"
+
+			default:
+				return nil, unsupportedTypeError([]string{name}, fmt.Sprintf("(StateUpgrader) %s", typ.FriendlyName()))
+			}
+
+			// Prior state is only read, so all attributes are Optional.
+			fprintf(&sbSchema, "%q:%sOptional:true,\n},\n", name, attribute)
+
+			priorFields[name] = modelField{GoName: naming.ToCamelCase(name), GoType: goType, Name: name}
+		}
+
+		fprintf(&sbSchema, "},\n")
+
+		if len(timeoutNames) > 0 {
+			e.HasPriorTimeouts = true
+
+			fprintf(&sbSchema, "Blocks: map[string]schema.Block{\n")
+			fprintf(&sbSchema, "\"timeouts\":timeouts.Block(ctx, timeouts.Opts{\n")
+			for _, v := range timeoutNames {
+				fprintf(&sbSchema, "%s:true,\n", naming.ToCamelCase(v))
+			}
+			fprintf(&sbSchema, "}),\n")
+			fprintf(&sbSchema, "},\n")
+		}
+
+		fprintf(&sbSchema, "}")
+
+		for _, name := range names {
+			if v, ok := priorFields[name]; ok {
+				fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", v.GoName, v.GoType, v.Name)
+			}
+		}
+
+		currentNames := make([]string, 0)
+		for name := range fields {
+			currentNames = append(currentNames, name)
+		}
+		sort.Strings(currentNames)
+
+		prior := fmt.Sprintf("dataV%d", upgrader.Version)
+
+		for _, name := range currentNames {
+			field := fields[name]
+			priorField, ok := priorFields[name]
+
+			if !ok {
+				if name == "timeouts" {
+					fprintf(&sbFields, "%s:%s,\n", field.GoName, e.nullTimeoutsValue())
+					continue
+				}
+
+				if v := nullValue(field.GoType); v != "" {
+					fprintf(&sbFields, "%s:%s,\n", field.GoName, v)
+				} else if !strings.HasPrefix(field.GoType, "types.") || isCollectionType(field.GoType) {
+					fprintf(&sbFields, "// TODO %s:,\n", field.GoName)
+				}
+				continue
+			}
+
+			switch {
+			case field.GoType == priorField.GoType:
+				fprintf(&sbFields, "%s:%s.%s,\n", field.GoName, prior, priorField.GoName)
+
+			case field.GoType == "fwtypes.ARN" && priorField.GoType == "types.String":
+				e.ImportProviderFrameworkTypes = true
+				fprintf(&sbConversions, "if v := %s.%s.ValueString(); v != \"\" {\n", prior, priorField.GoName)
+				fprintf(&sbConversions, "data.%s = fwtypes.ARNValueMust(v)\n", field.GoName)
+				fprintf(&sbConversions, "} else {\n")
+				fprintf(&sbConversions, "data.%s = fwtypes.ARNNull()\n", field.GoName)
+				fprintf(&sbConversions, "}\n")
+
+			default:
+				if embedded := embeddedValue(field.GoType); embedded != "" && "types."+strings.TrimSuffix(embedded, "Value") == priorField.GoType {
+					fprintf(&sbFields, "%s:%s{%s:%s.%s},\n", field.GoName, field.GoType, embedded, prior, priorField.GoName)
+				} else {
+					fprintf(&sbFields, "// TODO %s:%s.%s,\n", field.GoName, prior, priorField.GoName)
+				}
+			}
+		}
+
+		data.Conversions = sbConversions.String()
+		data.Fields = strings.TrimSuffix(sbFields.String(), "\n")
+		data.Schema = sbSchema.String()
+		data.Struct = strings.TrimSuffix(sbStruct.String(), "\n")
+
+		upgraders = append(upgraders, data)
+	}
+
+	return upgraders, nil
+}
+
+// emitCtyType generates the Plugin Framework code for a cty.Type's attr.Type.
+func (e *emitter) emitCtyType(path []string, typ cty.Type) (string, error) {
+	switch {
+	case typ == cty.String:
+		return "types.StringType", nil
+
+	case typ == cty.Bool:
+		return "types.BoolType", nil
+
+	case typ == cty.Number:
+		return "types.NumberType", nil
+
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		elementType, err := e.emitCtyType(path, typ.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case typ.IsListType():
+			return fmt.Sprintf("types.ListType{ElemType:%s}", elementType), nil
+		case typ.IsSetType():
+			return fmt.Sprintf("types.SetType{ElemType:%s}", elementType), nil
+		default:
+			return fmt.Sprintf("types.MapType{ElemType:%s}", elementType), nil
+		}
+
+	case typ.IsObjectType():
+		attributeTypes, err := e.emitCtyAttributeTypes(path, typ)
+
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{AttrTypes:%s}", attributeTypes), nil
+	}
+
+	return "", unsupportedTypeError(path, fmt.Sprintf("(StateUpgrader) %s", typ.FriendlyName()))
+}
+
+// emitCtyAttributeTypes generates the Plugin Framework code for a cty object type's attribute types.
+// Attribute names are sorted prior to code generation to reduce diffs.
+func (e *emitter) emitCtyAttributeTypes(path []string, typ cty.Type) (string, error) {
+	attributeTypes := typ.AttributeTypes()
+	names := make([]string, 0)
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	e.ImportFrameworkAttr = true
+
+	sb := strings.Builder{}
+	fprintf(&sb, "map[string]attr.Type{\n")
+	for _, name := range names {
+		v, err := e.emitCtyType(append(path, name), attributeTypes[name])
+
+		if err != nil {
+			return "", err
+		}
+
+		fprintf(&sb, "%q:%s,\n", name, v)
+	}
+	fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
+// nullTimeoutsValue returns an expression for a null timeouts value with the resource's timeouts.
+func (e *emitter) nullTimeoutsValue() string {
+	e.ImportFrameworkAttr = true
+
+	sb := strings.Builder{}
+	fprintf(&sb, "timeouts.Value{Object:types.ObjectNull(map[string]attr.Type{\n")
+	for _, v := range []struct {
+		name    string
+		timeout goDuration
+	}{
+		{"create", e.DefaultCreateTimeout},
+		{"delete", e.DefaultDeleteTimeout},
+		{"read", e.DefaultReadTimeout},
+		{"update", e.DefaultUpdateTimeout},
+	} {
+		if v.timeout > 0 {
+			fprintf(&sb, "%q:types.StringType,\n", v.name)
+		}
+	}
+	fprintf(&sb, "})}")
+
+	return sb.String()
+}
+
+// embeddedValue returns the name of the basetypes value embedded in a provider custom value type, e.g. ListValue.
+func embeddedValue(goType string) string {
+	for _, v := range []string{"List", "Set", "Map"} {
+		if strings.HasPrefix(goType, fmt.Sprintf("fwtypes.%sValueOf[", v)) || strings.HasPrefix(goType, fmt.Sprintf("fwtypes.%sNestedObjectValueOf[", v)) {
+			return v + "Value"
+		}
+	}
+
+	return ""
+}
+
+// nullValue returns an expression for the null value of a provider custom value type.
+func nullValue(goType string) string {
+	if goType == "fwtypes.ARN" {
+		return "fwtypes.ARNNull()"
+	}
+
+	if i := strings.Index(goType, "["); i > 0 && strings.HasPrefix(goType, "fwtypes.") {
+		return fmt.Sprintf("fwtypes.New%sNull%s(ctx)", strings.TrimPrefix(goType[:i], "fwtypes."), goType[i:])
+	}
+
+	return ""
+}
+
+// isCollectionType returns whether the specified Plugin Framework value type requires an element type.
+func isCollectionType(goType string) bool {
+	switch goType {
+	case "types.List", "types.Map", "types.Object", "types.Set":
+		return true
+	}
+
+	return false
+}